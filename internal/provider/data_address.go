@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"math/big"
+	"net/netip"
 	"strings"
 )
 
@@ -20,3 +22,43 @@ func completeIPv4Address(input string) string {
 		return input
 	}
 }
+
+// addrToBigInt returns the numerical value of an address.
+func addrToBigInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+// addrFromBigInt returns the IPv4 (if is4) or IPv6 address with the numerical value n.
+// It returns false if n is outside of the address space.
+func addrFromBigInt(n *big.Int, is4 bool) (netip.Addr, bool) {
+	size := 16
+	if is4 {
+		size = 4
+	}
+	if n.Sign() < 0 || n.BitLen() > size*8 {
+		return netip.Addr{}, false
+	}
+
+	addr, ok := netip.AddrFromSlice(n.FillBytes(make([]byte, size)))
+
+	return addr, ok
+}
+
+// addrAdd returns the address at offset (can be negative) from addr.
+// It returns false if the result is outside of the address space of addr.
+func addrAdd(addr netip.Addr, offset *big.Int) (netip.Addr, bool) {
+	value := new(big.Int).Add(addrToBigInt(addr), offset)
+
+	return addrFromBigInt(value, addr.Is4())
+}
+
+// addrDistance returns the number of addresses from a to b
+// (negative if b is lower than a).
+// It returns false if a and b are not the same IP version.
+func addrDistance(a, b netip.Addr) (*big.Int, bool) {
+	if a.Is4() != b.Is4() {
+		return nil, false
+	}
+
+	return new(big.Int).Sub(addrToBigInt(b), addrToBigInt(a)), true
+}
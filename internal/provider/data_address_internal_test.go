@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+)
+
+func TestAddrAdd(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		addr   netip.Addr
+		offset *big.Int
+		output netip.Addr
+		ok     bool
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			addr:   netip.MustParseAddr("192.0.2.1"),
+			offset: big.NewInt(10),
+			output: netip.MustParseAddr("192.0.2.11"),
+			ok:     true,
+		},
+		"ipv4_carry": {
+			addr:   netip.MustParseAddr("192.0.2.255"),
+			offset: big.NewInt(1),
+			output: netip.MustParseAddr("192.0.3.0"),
+			ok:     true,
+		},
+		"ipv4_negative": {
+			addr:   netip.MustParseAddr("192.0.2.0"),
+			offset: big.NewInt(-1),
+			output: netip.MustParseAddr("192.0.1.255"),
+			ok:     true,
+		},
+		"ipv4_overflow": {
+			addr:   netip.MustParseAddr("255.255.255.255"),
+			offset: big.NewInt(1),
+		},
+		"ipv4_underflow": {
+			addr:   netip.MustParseAddr("0.0.0.0"),
+			offset: big.NewInt(-1),
+		},
+		"ipv6": {
+			addr:   netip.MustParseAddr("2001:db8::"),
+			offset: new(big.Int).Lsh(big.NewInt(1), 64),
+			output: netip.MustParseAddr("2001:db8:0:1::"),
+			ok:     true,
+		},
+		"ipv6_overflow": {
+			addr:   netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"),
+			offset: big.NewInt(1),
+		},
+		"ipv4_mapped": {
+			addr:   netip.MustParseAddr("::ffff:192.0.2.255"),
+			offset: big.NewInt(1),
+			output: netip.MustParseAddr("::ffff:192.0.3.0"),
+			ok:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := addrAdd(test.addr, test.offset)
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected address: want %s, got %s", test.output, result)
+			}
+		})
+	}
+}
+
+func TestAddrDistance(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		a      netip.Addr
+		b      netip.Addr
+		output string
+		ok     bool
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			a:      netip.MustParseAddr("192.0.2.1"),
+			b:      netip.MustParseAddr("192.0.3.0"),
+			output: "255",
+			ok:     true,
+		},
+		"ipv4_negative": {
+			a:      netip.MustParseAddr("192.0.3.0"),
+			b:      netip.MustParseAddr("192.0.2.1"),
+			output: "-255",
+			ok:     true,
+		},
+		"ipv4_same": {
+			a:      netip.MustParseAddr("192.0.2.1"),
+			b:      netip.MustParseAddr("192.0.2.1"),
+			output: "0",
+			ok:     true,
+		},
+		"ipv6_full": {
+			a:      netip.MustParseAddr("::"),
+			b:      netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"),
+			output: "340282366920938463463374607431768211455",
+			ok:     true,
+		},
+		"different_families": {
+			a: netip.MustParseAddr("192.0.2.1"),
+			b: netip.MustParseAddr("::ffff:192.0.2.1"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := addrDistance(test.a, test.b)
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if ok && result.String() != test.output {
+				t.Errorf("got unexpected distance: want %s, got %s", test.output, result.String())
+			}
+		})
+	}
+}
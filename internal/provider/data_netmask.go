@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"math/bits"
 	"net/netip"
 )
@@ -46,3 +47,76 @@ func ipAddrToMaskBits(mask netip.Addr) (_ int, _ bool) {
 			bits.OnesCount8(maskOcts[3]),
 		true
 }
+
+// maskBitsToIPAddr returns the netmask in address format
+// of an IPv4 (if is4) or IPv6 prefix with a prefix length of maskBits.
+func maskBitsToIPAddr(maskBits int, is4 bool) netip.Addr {
+	size := 16
+	if is4 {
+		size = 4
+	}
+
+	mask := make([]byte, size)
+	for i := range mask {
+		switch {
+		case maskBits >= 8:
+			mask[i] = 0xff
+			maskBits -= 8
+		case maskBits > 0:
+			mask[i] = ^byte(0xff >> maskBits)
+			maskBits = 0
+		}
+	}
+
+	addr, _ := netip.AddrFromSlice(mask)
+
+	return addr
+}
+
+// ipAddrInvert returns the address with all bits inverted
+// (e.g. the wildcard mask of a netmask).
+func ipAddrInvert(addr netip.Addr) netip.Addr {
+	b := addr.AsSlice()
+	for i := range b {
+		b[i] = ^b[i]
+	}
+
+	inverted, _ := netip.AddrFromSlice(b)
+
+	return inverted
+}
+
+// ipAddrMaskString returns the mask in address format
+// with IPv6 mask always in hexadecimal format
+// (netip.Addr.String uses the IPv4-mapped IPv6 format for a wildcard mask of a /80).
+func ipAddrMaskString(mask netip.Addr) string {
+	if mask.Is4In6() {
+		b := mask.As16()
+
+		return fmt.Sprintf("::ffff:%x:%x", uint16(b[12])<<8|uint16(b[13]), uint16(b[14])<<8|uint16(b[15]))
+	}
+
+	return mask.String()
+}
+
+// ip6AddrToMaskBits returns the prefix length of an IPv6 netmask in address format.
+// It returns false if mask is not an IPv6 address or not a contiguous netmask.
+func ip6AddrToMaskBits(mask netip.Addr) (int, bool) {
+	if !mask.IsValid() || !mask.Is6() || mask.Is4In6() {
+		return 0, false
+	}
+
+	maskBits := 0
+	for _, b := range mask.AsSlice() {
+		maskBits += bits.LeadingZeros8(^b)
+		if b != 0xff {
+			break
+		}
+	}
+
+	if maskBitsToIPAddr(maskBits, false) != mask {
+		return 0, false
+	}
+
+	return maskBits, true
+}
@@ -117,3 +117,176 @@ func TestIpAddrToMaskBits(t *testing.T) {
 		})
 	}
 }
+
+func TestMaskBitsToIPAddr(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		maskBits int
+		is4      bool
+		expect   netip.Addr
+	}
+
+	tests := map[string]testCase{
+		"ipv4_0": {
+			maskBits: 0,
+			is4:      true,
+			expect:   netip.MustParseAddr("0.0.0.0"),
+		},
+		"ipv4_12": {
+			maskBits: 12,
+			is4:      true,
+			expect:   netip.MustParseAddr("255.240.0.0"),
+		},
+		"ipv4_24": {
+			maskBits: 24,
+			is4:      true,
+			expect:   netip.MustParseAddr("255.255.255.0"),
+		},
+		"ipv4_31": {
+			maskBits: 31,
+			is4:      true,
+			expect:   netip.MustParseAddr("255.255.255.254"),
+		},
+		"ipv4_32": {
+			maskBits: 32,
+			is4:      true,
+			expect:   netip.MustParseAddr("255.255.255.255"),
+		},
+		"ipv6_0": {
+			maskBits: 0,
+			is4:      false,
+			expect:   netip.MustParseAddr("::"),
+		},
+		"ipv6_57": {
+			maskBits: 57,
+			is4:      false,
+			expect:   netip.MustParseAddr("ffff:ffff:ffff:ff80::"),
+		},
+		"ipv6_128": {
+			maskBits: 128,
+			is4:      false,
+			expect:   netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := maskBitsToIPAddr(test.maskBits, test.is4)
+			if result != test.expect {
+				t.Errorf("got unexpected mask: want %s, got %s", test.expect, result)
+			}
+
+			// converting back to prefix length must give the same value
+			if test.is4 {
+				maskBits, ok := ipAddrToMaskBits(result)
+				if !ok || maskBits != test.maskBits {
+					t.Errorf("got unexpected prefix length of mask: want %d, got %d", test.maskBits, maskBits)
+				}
+			}
+		})
+	}
+}
+
+func TestIp6AddrToMaskBits(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		mask       netip.Addr
+		expectOk   bool
+		expectBits int
+	}
+
+	tests := map[string]testCase{
+		"0": {
+			mask:       netip.MustParseAddr("::"),
+			expectOk:   true,
+			expectBits: 0,
+		},
+		"48": {
+			mask:       netip.MustParseAddr("ffff:ffff:ffff::"),
+			expectOk:   true,
+			expectBits: 48,
+		},
+		"57": {
+			mask:       netip.MustParseAddr("ffff:ffff:ffff:ff80::"),
+			expectOk:   true,
+			expectBits: 57,
+		},
+		"~57": {
+			mask:       netip.MustParseAddr("ffff:ffff:ffff:ff80::1"),
+			expectOk:   false,
+			expectBits: 0,
+		},
+		"~64": {
+			mask:       netip.MustParseAddr("ffff:ffff:ffff:fffe:8000::"),
+			expectOk:   false,
+			expectBits: 0,
+		},
+		"128": {
+			mask:       netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"),
+			expectOk:   true,
+			expectBits: 128,
+		},
+		"ipv4": {
+			mask:       netip.MustParseAddr("255.255.255.0"),
+			expectOk:   false,
+			expectBits: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bits, ok := ip6AddrToMaskBits(test.mask)
+
+			if test.expectBits != bits {
+				t.Errorf("got unexpected bits: want %d, got %d", test.expectBits, bits)
+			}
+			if test.expectOk != ok {
+				t.Errorf("got unexpected ok: want %t, got %t", test.expectOk, ok)
+			}
+		})
+	}
+}
+
+func TestIpAddrMaskString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		mask   netip.Addr
+		expect string
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			mask:   netip.MustParseAddr("0.0.0.255"),
+			expect: "0.0.0.255",
+		},
+		"ipv6": {
+			mask:   netip.MustParseAddr("::ffff:ffff:ffff:ffff"),
+			expect: "::ffff:ffff:ffff:ffff",
+		},
+		"ipv6_80": {
+			mask:   netip.MustParseAddr("::ffff:ffff:ffff"),
+			expect: "::ffff:ffff:ffff",
+		},
+		"ipv6_netmask_80": {
+			mask:   netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff::"),
+			expect: "ffff:ffff:ffff:ffff:ffff::",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ipAddrMaskString(test.mask); got != test.expect {
+				t.Errorf("got unexpected mask: want %q, got %q", test.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"math/big"
+	"strings"
+)
+
+// parseBigInt parses a signed integer in decimal format
+// or in hexadecimal format with the 0x prefix.
+func parseBigInt(input string) (*big.Int, bool) {
+	input = strings.TrimSpace(input)
+
+	negative := false
+	switch {
+	case strings.HasPrefix(input, "-"):
+		negative = true
+		input = input[1:]
+	case strings.HasPrefix(input, "+"):
+		input = input[1:]
+	}
+
+	base := 10
+	if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+		base = 16
+		input = input[2:]
+	}
+
+	// sign has already been read
+	if input == "" || strings.HasPrefix(input, "-") || strings.HasPrefix(input, "+") {
+		return nil, false
+	}
+
+	n, ok := new(big.Int).SetString(input, base)
+	if !ok {
+		return nil, false
+	}
+	if negative {
+		n.Neg(n)
+	}
+
+	return n, true
+}
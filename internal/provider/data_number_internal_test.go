@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseBigInt(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    string
+		expectOk bool
+		output   string
+	}
+
+	tests := map[string]testCase{
+		"zero": {
+			input:    "0",
+			expectOk: true,
+			output:   "0",
+		},
+		"decimal": {
+			input:    "1234",
+			expectOk: true,
+			output:   "1234",
+		},
+		"decimal_leading_zero": {
+			input:    "010",
+			expectOk: true,
+			output:   "10",
+		},
+		"decimal_spaces": {
+			input:    " 42 ",
+			expectOk: true,
+			output:   "42",
+		},
+		"decimal_positive": {
+			input:    "+42",
+			expectOk: true,
+			output:   "42",
+		},
+		"decimal_negative": {
+			input:    "-42",
+			expectOk: true,
+			output:   "-42",
+		},
+		"decimal_128bits": {
+			input:    "340282366920938463463374607431768211455",
+			expectOk: true,
+			output:   "340282366920938463463374607431768211455",
+		},
+		"hexadecimal": {
+			input:    "0xff",
+			expectOk: true,
+			output:   "255",
+		},
+		"hexadecimal_upper": {
+			input:    "0XFF",
+			expectOk: true,
+			output:   "255",
+		},
+		"hexadecimal_negative": {
+			input:    "-0x10",
+			expectOk: true,
+			output:   "-16",
+		},
+		"empty": {
+			input:    "",
+			expectOk: false,
+		},
+		"only_sign": {
+			input:    "-",
+			expectOk: false,
+		},
+		"only_hex_prefix": {
+			input:    "0x",
+			expectOk: false,
+		},
+		"double_sign": {
+			input:    "--1",
+			expectOk: false,
+		},
+		"hex_without_prefix": {
+			input:    "ff",
+			expectOk: false,
+		},
+		"underscore": {
+			input:    "1_000",
+			expectOk: false,
+		},
+		"decimal_point": {
+			input:    "1.5",
+			expectOk: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			n, ok := parseBigInt(test.input)
+			if ok != test.expectOk {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.expectOk, ok)
+			}
+			if ok && n.String() != test.output {
+				t.Errorf("got unexpected number: want %s, got %s", test.output, n.String())
+			}
+		})
+	}
+}
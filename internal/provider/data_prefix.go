@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"math/bits"
+	"net/netip"
+	"slices"
+)
+
+// prefixSibling returns the other half of the parent prefix of prefix.
+func prefixSibling(prefix netip.Prefix) netip.Prefix {
+	if prefix.Bits() <= 0 {
+		return prefix
+	}
+
+	addr := prefix.Masked().Addr()
+	b := addr.As16()
+
+	// For IPv4-in-IPv6 representation, IPv4 bytes start at offset 12
+	byteOffset := 0
+	if addr.Is4() {
+		byteOffset = 12
+	}
+
+	// Flip the last bit of the prefix
+	i := prefix.Bits() - 1
+	b[byteOffset+i/8] ^= 1 << (7 - (i % 8))
+
+	if addr.Is4() {
+		return netip.PrefixFrom(netip.AddrFrom16(b).Unmap(), prefix.Bits())
+	}
+
+	return netip.PrefixFrom(netip.AddrFrom16(b), prefix.Bits())
+}
+
+// prefixSubtract returns, in numerical order, the minimal list of prefixes
+// that cover the addresses of prefix without the addresses of sub.
+func prefixSubtract(prefix, sub netip.Prefix) []netip.Prefix {
+	prefix = prefix.Masked()
+	sub = sub.Masked()
+
+	if !prefix.Overlaps(sub) {
+		return []netip.Prefix{prefix}
+	}
+	if sub.Bits() <= prefix.Bits() {
+		// sub covers all prefix
+		return []netip.Prefix{}
+	}
+
+	// at each level between prefix and sub, keep the half which doesn't contain sub
+	result := make([]netip.Prefix, 0, sub.Bits()-prefix.Bits())
+	for bits := prefix.Bits() + 1; bits <= sub.Bits(); bits++ {
+		result = append(result, prefixSibling(netip.PrefixFrom(sub.Addr(), bits).Masked()))
+	}
+
+	slices.SortFunc(result, func(a, b netip.Prefix) int {
+		return a.Addr().Compare(b.Addr())
+	})
+
+	return result
+}
+
+// prefixesSupernet returns the shortest prefix that contains all prefixes.
+// It returns false if prefixes is empty or contains IPv4 and IPv6 prefixes.
+func prefixesSupernet(prefixes []netip.Prefix) (netip.Prefix, bool) {
+	if len(prefixes) == 0 {
+		return netip.Prefix{}, false
+	}
+
+	supernet := prefixes[0].Masked()
+	for _, prefix := range prefixes[1:] {
+		if prefix.Addr().Is4() != supernet.Addr().Is4() {
+			return netip.Prefix{}, false
+		}
+
+		bits := min(supernet.Bits(), prefix.Bits(), addrCommonBits(supernet.Addr(), prefix.Addr()))
+		supernet = netip.PrefixFrom(supernet.Addr(), bits).Masked()
+	}
+
+	return supernet, true
+}
+
+// addrCommonBits returns the number of leading bits shared by a and b
+// (a and b must be the same IP version).
+func addrCommonBits(a, b netip.Addr) int {
+	a16 := a.As16()
+	b16 := b.As16()
+
+	common := 0
+	for i := range a16 {
+		if x := a16[i] ^ b16[i]; x != 0 {
+			common += bits.LeadingZeros8(x)
+
+			break
+		}
+		common += 8
+	}
+
+	// For IPv4-in-IPv6 representation, the first 96 bits are always the same
+	if a.Is4() {
+		common -= 96
+	}
+
+	return common
+}
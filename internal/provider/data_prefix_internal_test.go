@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixSibling(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix netip.Prefix
+		output netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"ipv4_first_half": {
+			prefix: netip.MustParsePrefix("192.0.2.0/25"),
+			output: netip.MustParsePrefix("192.0.2.128/25"),
+		},
+		"ipv4_second_half": {
+			prefix: netip.MustParsePrefix("192.0.2.128/25"),
+			output: netip.MustParsePrefix("192.0.2.0/25"),
+		},
+		"ipv4_not_masked": {
+			prefix: netip.MustParsePrefix("192.0.2.130/25"),
+			output: netip.MustParsePrefix("192.0.2.0/25"),
+		},
+		"ipv4_32": {
+			prefix: netip.MustParsePrefix("192.0.2.1/32"),
+			output: netip.MustParsePrefix("192.0.2.0/32"),
+		},
+		"ipv4_1": {
+			prefix: netip.MustParsePrefix("0.0.0.0/1"),
+			output: netip.MustParsePrefix("128.0.0.0/1"),
+		},
+		"ipv4_0": {
+			prefix: netip.MustParsePrefix("0.0.0.0/0"),
+			output: netip.MustParsePrefix("0.0.0.0/0"),
+		},
+		"ipv6": {
+			prefix: netip.MustParsePrefix("2001:db8::/64"),
+			output: netip.MustParsePrefix("2001:db8:0:1::/64"),
+		},
+		"ipv6_128": {
+			prefix: netip.MustParsePrefix("2001:db8::ffff/128"),
+			output: netip.MustParsePrefix("2001:db8::fffe/128"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixSibling(test.prefix)
+			if result != test.output {
+				t.Errorf("got unexpected prefix: want %s, got %s", test.output, result)
+			}
+		})
+	}
+}
+
+func TestPrefixSubtract(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix netip.Prefix
+		sub    netip.Prefix
+		output []netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"ipv4_first": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("192.0.2.0/26"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.64/26"),
+				netip.MustParsePrefix("192.0.2.128/25"),
+			},
+		},
+		"ipv4_middle": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("192.0.2.64/26"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/26"),
+				netip.MustParsePrefix("192.0.2.128/25"),
+			},
+		},
+		"ipv4_last": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("192.0.2.255/32"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/25"),
+				netip.MustParsePrefix("192.0.2.128/26"),
+				netip.MustParsePrefix("192.0.2.192/27"),
+				netip.MustParsePrefix("192.0.2.224/28"),
+				netip.MustParsePrefix("192.0.2.240/29"),
+				netip.MustParsePrefix("192.0.2.248/30"),
+				netip.MustParsePrefix("192.0.2.252/31"),
+				netip.MustParsePrefix("192.0.2.254/32"),
+			},
+		},
+		"ipv4_no_overlap": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("198.51.100.0/24"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+			},
+		},
+		"ipv4_same": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("192.0.2.0/24"),
+			output: []netip.Prefix{},
+		},
+		"ipv4_larger": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("192.0.0.0/16"),
+			output: []netip.Prefix{},
+		},
+		"different_families": {
+			prefix: netip.MustParsePrefix("192.0.2.0/24"),
+			sub:    netip.MustParsePrefix("::/0"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+			},
+		},
+		"ipv6": {
+			prefix: netip.MustParsePrefix("2001:db8::/62"),
+			sub:    netip.MustParsePrefix("2001:db8:0:2::/64"),
+			output: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/63"),
+				netip.MustParsePrefix("2001:db8:0:3::/64"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixSubtract(test.prefix, test.sub)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
+
+func TestPrefixesSupernet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes []netip.Prefix
+		output   netip.Prefix
+		ok       bool
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixes: []netip.Prefix{},
+		},
+		"single": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.1/24"),
+			},
+			output: netip.MustParsePrefix("192.0.2.0/24"),
+			ok:     true,
+		},
+		"ipv4_siblings": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+			},
+			output: netip.MustParsePrefix("10.0.0.0/23"),
+			ok:     true,
+		},
+		"ipv4_contained": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("10.0.0.0/16"),
+			},
+			output: netip.MustParsePrefix("10.0.0.0/16"),
+			ok:     true,
+		},
+		"ipv4_hosts": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.1/32"),
+				netip.MustParsePrefix("10.0.0.130/32"),
+				netip.MustParsePrefix("10.0.0.62/32"),
+			},
+			output: netip.MustParsePrefix("10.0.0.0/24"),
+			ok:     true,
+		},
+		"ipv4_all": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("0.0.0.0/32"),
+				netip.MustParsePrefix("255.255.255.255/32"),
+			},
+			output: netip.MustParsePrefix("0.0.0.0/0"),
+			ok:     true,
+		},
+		"ipv6": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/48"),
+				netip.MustParsePrefix("2001:db8:3::/48"),
+			},
+			output: netip.MustParsePrefix("2001:db8::/46"),
+			ok:     true,
+		},
+		"ipv6_same": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::1/128"),
+				netip.MustParsePrefix("2001:db8::1/128"),
+			},
+			output: netip.MustParsePrefix("2001:db8::1/128"),
+			ok:     true,
+		},
+		"mixed_families": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/8"),
+				netip.MustParsePrefix("::ffff:10.0.0.0/104"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := prefixesSupernet(test.prefixes)
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected prefix: want %s, got %s", test.output, result)
+			}
+		})
+	}
+}
@@ -58,15 +58,27 @@ func (f addressFunction) Run(
 		return
 	}
 
+	output, funcErr := parseAddressInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// parseAddressInput parses an address with completion and cleanup
+// of incorrect/unwanted data (as address function)
+// and returns an argument error with position if the input is invalid.
+func parseAddressInput(input string, position int64) (netip.Addr, *function.FuncError) {
 	// remove potential mask
 	inputAddress, _, _ := strings.Cut(input, "/")
 
 	// clean potential leading or trailing white space
 	inputAddress = strings.TrimSpace(inputAddress)
 	if len(inputAddress) == 0 {
-		resp.Error = function.NewArgumentFuncError(0, "String only with space character(s)")
-
-		return
+		return netip.Addr{}, function.NewArgumentFuncError(position, "String only with space character(s)")
 	}
 
 	// remove potential scoped zone
@@ -80,13 +92,11 @@ func (f addressFunction) Run(
 	// read address
 	output, err := netip.ParseAddr(inputAddress)
 	if err != nil {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(0, "Invalid address"),
+		return netip.Addr{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid address"),
 			function.NewFuncError("unable to parse address input: "+err.Error()),
 		)
-
-		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+	return output, nil
 }
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = addressAddFunction{}
+
+func newAddressAddFunction() function.Function {
+	return addressAddFunction{}
+}
+
+type addressAddFunction struct{}
+
+func (f addressAddFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "address_add"
+}
+
+func (f addressAddFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Add an offset to an IP address.",
+		Description: "Add an offset to an IP address.\n" +
+			" Offset is a string to allow numbers above 64 bits and can be negative to subtract.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "IP address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "offset",
+				Description: "Offset in decimal or hexadecimal (0x prefix) format",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f addressAddFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputAddress, inputOffset string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputAddress, &inputOffset))
+	if resp.Error != nil {
+		return
+	}
+
+	address, funcErr := parseAddressInput(inputAddress, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	offset, ok := parseBigInt(inputOffset)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Invalid offset"),
+			function.NewFuncError("unable to parse offset input: "+
+				"must be an integer in decimal or hexadecimal (0x prefix) format"),
+		)
+
+		return
+	}
+
+	output, ok := addrAdd(address, offset)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Address overflow"),
+			function.NewFuncError("adding "+offset.String()+" to "+address.String()+
+				" is outside of the IP address space"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
@@ -0,0 +1,152 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAddressAdd(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		address     string
+		offset      string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty_address": {
+			address:     "",
+			offset:      "1",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"empty_offset": {
+			address:     "192.0.2.1",
+			offset:      "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_address": {
+			address:     "192.0.2.a",
+			offset:      "1",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"invalid_offset": {
+			address:     "192.0.2.1",
+			offset:      "1a",
+			expectError: regexp.MustCompile("Invalid offset"),
+		},
+		"ipv4": {
+			address: "192.0.2.1",
+			offset:  "10",
+			output:  "192.0.2.11",
+		},
+		"ipv4_carry": {
+			address: "192.0.2.255",
+			offset:  "1",
+			output:  "192.0.3.0",
+		},
+		"ipv4_negative": {
+			address: "192.0.2.1",
+			offset:  "-2",
+			output:  "192.0.1.255",
+		},
+		"ipv4_hex": {
+			address: "10.1",
+			offset:  "0x100",
+			output:  "10.1.1.0",
+		},
+		"ipv4_prefix": {
+			address: "192.0.2.1/24",
+			offset:  "1",
+			output:  "192.0.2.2",
+		},
+		"ipv4_last": {
+			address: "255.255.255.254",
+			offset:  "1",
+			output:  "255.255.255.255",
+		},
+		"ipv4_overflow": {
+			address:     "255.255.255.254",
+			offset:      "2",
+			expectError: regexp.MustCompile("Address overflow"),
+		},
+		"ipv4_underflow": {
+			address:     "0.0.0.1",
+			offset:      "-2",
+			expectError: regexp.MustCompile("Address overflow"),
+		},
+		"ipv6": {
+			address: "2001:db8::",
+			offset:  "18446744073709551616",
+			output:  "2001:db8:0:1::",
+		},
+		"ipv6_negative": {
+			address: "2001:db8::",
+			offset:  "-1",
+			output:  "2001:db7:ffff:ffff:ffff:ffff:ffff:ffff",
+		},
+		"ipv6_zone": {
+			address: "fe80::1%eth0",
+			offset:  "1",
+			output:  "fe80::2",
+		},
+		"ipv6_overflow": {
+			address:     "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+			offset:      "1",
+			expectError: regexp.MustCompile("Address overflow"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_add("` + test.address + `", "` + test.offset + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_add("` + test.address + `", "` + test.offset + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
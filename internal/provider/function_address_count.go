@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = addressCountFunction{}
+
+func newAddressCountFunction() function.Function {
+	return addressCountFunction{}
+}
+
+type addressCountFunction struct{}
+
+type addressCountFunctionResult struct {
+	IPv4 string `tfsdk:"ipv4"`
+	IPv6 string `tfsdk:"ipv6"`
+}
+
+func (f addressCountFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "address_count"
+}
+
+func (f addressCountFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Count distinct IP addresses covered by IP addresses and prefixes.",
+		Description: "Return, per IP version, the number of distinct IP addresses covered" +
+			" by a set of IP addresses and prefixes in decimal format." +
+			" Each address is counted only once, even if covered by overlapping prefixes.",
+		Parameters: []function.Parameter{
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "Set of IP addresses and prefixes to count",
+			},
+			function.BoolParameter{
+				Name: "usable_only",
+				Description: "(Optional) Count only usable hosts" +
+					" (network and broadcast addresses of IPv4 prefixes shorter than /31 are excluded)",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"ipv4": types.StringType,
+				"ipv6": types.StringType,
+			},
+		},
+	}
+}
+
+func (f addressCountFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputs          []string
+		inputUsableOnly types.Bool
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs, &inputUsableOnly))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(inputs))
+	for _, item := range inputs {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	countIPv4, countIPv6 := prefixesAddressCount(prefixes, inputUsableOnly.ValueBool())
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, addressCountFunctionResult{
+		IPv4: countIPv4.String(),
+		IPv6: countIPv6.String(),
+	}))
+}
+
+// prefixesAddressCount returns the number of distinct IPv4 and IPv6 addresses covered by prefixes.
+// With usableOnly, network and broadcast addresses of each IPv4 prefix shorter than /31 are excluded
+// before merging the ranges of prefixes, so an address is counted if it's usable in at least one prefix.
+func prefixesAddressCount(prefixes []netip.Prefix, usableOnly bool) (*big.Int, *big.Int) {
+	ranges := make([][2]netip.Addr, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		first, last := prefixHostRange(prefix, usableOnly, usableOnly)
+		ranges = append(ranges, [2]netip.Addr{first, last})
+	}
+
+	// sort by first address (IPv4 before IPv6) to merge overlapping and contiguous ranges
+	slices.SortFunc(ranges, func(a, b [2]netip.Addr) int {
+		return a[0].Compare(b[0])
+	})
+
+	merged := make([][2]netip.Addr, 0, len(ranges))
+	for _, r := range ranges {
+		if last := len(merged) - 1; last >= 0 &&
+			(r[0].Compare(merged[last][1]) <= 0 || r[0] == merged[last][1].Next()) {
+			if r[1].Compare(merged[last][1]) > 0 {
+				merged[last][1] = r[1]
+			}
+
+			continue
+		}
+
+		merged = append(merged, r)
+	}
+
+	countIPv4 := new(big.Int)
+	countIPv6 := new(big.Int)
+	for _, r := range merged {
+		count := new(big.Int).Sub(addrToBigInt(r[1]), addrToBigInt(r[0]))
+		count.Add(count, big.NewInt(1))
+
+		if r[0].Is4() {
+			countIPv4.Add(countIPv4, count)
+		} else {
+			countIPv6.Add(countIPv6, count)
+		}
+	}
+
+	return countIPv4, countIPv6
+}
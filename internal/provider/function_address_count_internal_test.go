@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestPrefixesAddressCount(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes   []netip.Prefix
+		usableOnly bool
+		ipv4       string
+		ipv6       string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixes: []netip.Prefix{},
+			ipv4:     "0",
+			ipv6:     "0",
+		},
+		"overlapping": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+				netip.MustParsePrefix("10.0.0.1/32"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+			},
+			ipv4: "512",
+			ipv6: "0",
+		},
+		"overlapping_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+				netip.MustParsePrefix("10.0.2.0/31"),
+				netip.MustParsePrefix("10.0.3.1/32"),
+			},
+			usableOnly: true,
+			ipv4:       "257",
+			ipv6:       "0",
+		},
+		"adjacent_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/25"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+			},
+			usableOnly: true,
+			ipv4:       "252",
+			ipv6:       "0",
+		},
+		"adjacent_31_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.2.0/31"),
+				netip.MustParsePrefix("10.0.2.2/31"),
+			},
+			usableOnly: true,
+			ipv4:       "4",
+			ipv6:       "0",
+		},
+		"addresses_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/32"),
+				netip.MustParsePrefix("10.0.0.1/32"),
+				netip.MustParsePrefix("10.0.0.2/32"),
+				netip.MustParsePrefix("10.0.0.3/32"),
+			},
+			usableOnly: true,
+			ipv4:       "4",
+			ipv6:       "0",
+		},
+		"contained_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.0.0/32"),
+				netip.MustParsePrefix("10.0.0.255/32"),
+			},
+			usableOnly: true,
+			ipv4:       "256",
+			ipv6:       "0",
+		},
+		"mixed": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+				netip.MustParsePrefix("2001:db8::/64"),
+				netip.MustParsePrefix("2001:db8::/32"),
+				netip.MustParsePrefix("::1/128"),
+			},
+			ipv4: "256",
+			ipv6: "79228162514264337593543950337",
+		},
+		"mixed_usable_only": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+				netip.MustParsePrefix("2001:db8::/127"),
+			},
+			usableOnly: true,
+			ipv4:       "254",
+			ipv6:       "2",
+		},
+		"all": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("0.0.0.0/0"),
+				netip.MustParsePrefix("::/0"),
+			},
+			ipv4: "4294967296",
+			ipv6: "340282366920938463463374607431768211456",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			countIPv4, countIPv6 := prefixesAddressCount(test.prefixes, test.usableOnly)
+			if countIPv4.String() != test.ipv4 || countIPv6.String() != test.ipv6 {
+				t.Errorf("got unexpected result: want %s and %s, got %s and %s",
+					test.ipv4, test.ipv6, countIPv4.String(), countIPv6.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAddressCount(t *testing.T) {
+	t.Parallel()
+
+	type testOutput struct {
+		ipv4 string
+		ipv6 string
+	}
+
+	type testCase struct {
+		input       []string
+		usableOnly  string
+		expectError *regexp.Regexp
+		output      testOutput
+	}
+
+	tests := map[string]testCase{
+		"overlapping": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.0.128/25",
+				"10.0.0.1",
+				"10.0.1.0/24",
+			},
+			usableOnly: `null`,
+			output: testOutput{
+				ipv4: "512",
+				ipv6: "0",
+			},
+		},
+		"usable_only": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.2.0/31",
+				"10.0.3.1",
+			},
+			usableOnly: `true`,
+			output: testOutput{
+				ipv4: "257",
+				ipv6: "0",
+			},
+		},
+		"usable_only_adjacent": {
+			input: []string{
+				"10.0.0.0/25",
+				"10.0.0.128/25",
+			},
+			usableOnly: `true`,
+			output: testOutput{
+				ipv4: "252",
+				ipv6: "0",
+			},
+		},
+		"usable_only_false": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.2.0/31",
+				"10.0.3.1",
+			},
+			usableOnly: `false`,
+			output: testOutput{
+				ipv4: "259",
+				ipv6: "0",
+			},
+		},
+		"mixed": {
+			input: []string{
+				"192.0.2.0/24",
+				"2001:db8::/64",
+				"2001:db8::/32",
+				"::1",
+			},
+			usableOnly: `null`,
+			output: testOutput{
+				ipv4: "256",
+				ipv6: "79228162514264337593543950337",
+			},
+		},
+		"all_ipv6": {
+			input: []string{
+				"::/0",
+			},
+			usableOnly: `true`,
+			output: testOutput{
+				ipv4: "0",
+				ipv6: "340282366920938463463374607431768211456",
+			},
+		},
+		"empty": {
+			input:      []string{},
+			usableOnly: `null`,
+			output: testOutput{
+				ipv4: "0",
+				ipv6: "0",
+			},
+		},
+		"invalid": {
+			input: []string{
+				"10.0.0.0/16",
+				"10.0.0.a",
+			},
+			usableOnly:  `null`,
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedInput := make([]string, len(test.input))
+			for i, v := range test.input {
+				quotedInput[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_count(toset([` + strings.Join(quotedInput, ", ") + `]), ` +
+								test.usableOnly + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_count(toset([` + strings.Join(quotedInput, ", ") + `]), ` +
+								test.usableOnly + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"ipv4": knownvalue.StringExact(test.output.ipv4),
+										"ipv6": knownvalue.StringExact(test.output.ipv6),
+									}),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
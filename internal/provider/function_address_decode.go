@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = addressDecodeFunction{}
+
+func newAddressDecodeFunction() function.Function {
+	return addressDecodeFunction{}
+}
+
+type addressDecodeFunction struct{}
+
+func (f addressDecodeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "address_decode"
+}
+
+func (f addressDecodeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Decode an IP address from a specific format.",
+		Description: "Decode an IP address from decimal, hexadecimal (network order or little-endian 32-bit words)" +
+			" or binary format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Encoded IP address to decode",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: "Input format: `decimal`, `hex`, `hex_le` or `binary`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						addressFormatDecimal,
+						addressFormatHex,
+						addressFormatHexLE,
+						addressFormatBinary,
+					),
+				},
+			},
+			function.StringParameter{
+				Name:           "family",
+				Description:    "(Optional) Address family of the IP address: `ipv4` or `ipv6`",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf("ipv4", "ipv6"),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f addressDecodeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		value, format string
+		inputFamily   types.String
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &format, &inputFamily))
+	if resp.Error != nil {
+		return
+	}
+
+	output, ok := addrDecode(value, format, inputFamily.ValueString())
+	if !ok {
+		var expected string
+		switch format {
+		case addressFormatDecimal:
+			expected = "an integer in decimal format lower than 2^32 for IPv4 or 2^128 for IPv6"
+		case addressFormatBinary:
+			expected = "32 (IPv4) or 128 (IPv6) binary digits"
+		default:
+			expected = "8 (IPv4) or 32 (IPv6) hexadecimal digits"
+		}
+		if !inputFamily.IsNull() {
+			expected += " with family " + inputFamily.ValueString()
+		}
+
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid value"),
+			function.NewFuncError("unable to decode value in "+format+" format: must be "+expected),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// addrDecode returns the address encoded in value with format.
+// With an empty family, the family is detected with the length of value
+// (or with the numerical value for decimal format).
+// It returns false if value is invalid for format and family.
+func addrDecode(value, format, family string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+
+	var b []byte
+	switch format {
+	case addressFormatDecimal:
+		n, ok := new(big.Int).SetString(value, 10)
+		if !ok || n.Sign() < 0 {
+			return netip.Addr{}, false
+		}
+		if family == "" {
+			family = "ipv6"
+			if n.BitLen() <= 32 {
+				family = "ipv4"
+			}
+		}
+
+		return addrFromBigInt(n, family == "ipv4")
+	case addressFormatBinary:
+		if strings.Trim(value, "01") != "" || len(value)%8 != 0 {
+			return netip.Addr{}, false
+		}
+		b = make([]byte, len(value)/8)
+		for i := range b {
+			for _, c := range value[i*8 : i*8+8] {
+				b[i] = b[i]<<1 | byte(c-'0')
+			}
+		}
+	default:
+		value = strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X")
+
+		var err error
+		b, err = hex.DecodeString(value)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		if format == addressFormatHexLE {
+			b = bytesSwap32(b)
+		}
+	}
+
+	switch {
+	case len(b) == 4 && family != "ipv6":
+		return netip.AddrFrom4([4]byte(b)), true
+	case len(b) == 16 && family != "ipv4":
+		return netip.AddrFrom16([16]byte(b)), true
+	default:
+		return netip.Addr{}, false
+	}
+}
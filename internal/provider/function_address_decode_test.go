@@ -0,0 +1,194 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAddressDecode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		value       string
+		format      string
+		family      string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			value:       "",
+			format:      "hex",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_format": {
+			value:       "c0a80201",
+			format:      "octal",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"invalid_family": {
+			value:       "c0a80201",
+			format:      "hex",
+			family:      "\"ipv5\"",
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"ipv4_decimal": {
+			value:  "3232236033",
+			format: "decimal",
+			family: "null",
+			output: "192.168.2.1",
+		},
+		"ipv4_decimal_zero": {
+			value:  "0",
+			format: "decimal",
+			family: "null",
+			output: "0.0.0.0",
+		},
+		"ipv4_hex": {
+			value:  "c0a80201",
+			format: "hex",
+			family: "null",
+			output: "192.168.2.1",
+		},
+		"ipv4_hex_prefix": {
+			value:  "0xC0A80201",
+			format: "hex",
+			family: "null",
+			output: "192.168.2.1",
+		},
+		"ipv4_hex_le": {
+			value:  "0102A8C0",
+			format: "hex_le",
+			family: "null",
+			output: "192.168.2.1",
+		},
+		"ipv4_binary": {
+			value:  "11000000101010000000001000000001",
+			format: "binary",
+			family: "null",
+			output: "192.168.2.1",
+		},
+		"ipv6_decimal": {
+			value:  "42540766411282592856903984951653826561",
+			format: "decimal",
+			family: "null",
+			output: "2001:db8::1",
+		},
+		"ipv6_decimal_family": {
+			value:  "1",
+			format: "decimal",
+			family: "\"ipv6\"",
+			output: "::1",
+		},
+		"ipv6_hex": {
+			value:  "20010db8000000000000000000000001",
+			format: "hex",
+			family: "null",
+			output: "2001:db8::1",
+		},
+		"ipv6_hex_le": {
+			value:  "b80d0120000000000000000001000000",
+			format: "hex_le",
+			family: "\"ipv6\"",
+			output: "2001:db8::1",
+		},
+		"ipv6_binary": {
+			value: "0000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+			format: "binary",
+			family: "null",
+			output: "::1",
+		},
+		"decimal_negative": {
+			value:       "-1",
+			format:      "decimal",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+		"decimal_too_big_ipv4": {
+			value:       "4294967296",
+			format:      "decimal",
+			family:      "\"ipv4\"",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+		"hex_invalid": {
+			value:       "c0a8020g",
+			format:      "hex",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+		"hex_invalid_length": {
+			value:       "c0a802",
+			format:      "hex",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+		"hex_wrong_family": {
+			value:       "c0a80201",
+			format:      "hex",
+			family:      "\"ipv6\"",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+		"binary_invalid_length": {
+			value:       "1100000010101000000000100000000",
+			format:      "binary",
+			family:      "null",
+			expectError: regexp.MustCompile("Invalid value"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_decode("` + test.value + `", "` + test.format + `", ` + test.family + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_decode("` + test.value + `", "` + test.format + `", ` + test.family + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
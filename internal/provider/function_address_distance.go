@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = addressDistanceFunction{}
+
+func newAddressDistanceFunction() function.Function {
+	return addressDistanceFunction{}
+}
+
+type addressDistanceFunction struct{}
+
+func (f addressDistanceFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "address_distance"
+}
+
+func (f addressDistanceFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate the distance between two IP addresses.",
+		Description: "Calculate the number of addresses from a first IP address to a second IP address" +
+			" (negative if the second is lower than the first).\n" +
+			" Distance is a string in decimal format to allow numbers above 64 bits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address_a",
+				Description: "First IP address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "address_b",
+				Description: "Second IP address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f addressDistanceFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputAddressA, inputAddressB string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputAddressA, &inputAddressB))
+	if resp.Error != nil {
+		return
+	}
+
+	addressA, funcErr := parseAddressInput(inputAddressA, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	addressB, funcErr := parseAddressInput(inputAddressB, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	output, ok := addrDistance(addressA, addressB)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Invalid address"),
+			function.NewFuncError("must be the same IP version as address_a"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
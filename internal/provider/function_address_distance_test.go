@@ -0,0 +1,127 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAddressDistance(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		addressA    string
+		addressB    string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty_address_a": {
+			addressA:    "",
+			addressB:    "192.0.2.1",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"empty_address_b": {
+			addressA:    "192.0.2.1",
+			addressB:    "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_address_a": {
+			addressA:    "192.0.2.a",
+			addressB:    "192.0.2.1",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"invalid_address_b": {
+			addressA:    "192.0.2.1",
+			addressB:    "192.0.2.a",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"ipv4": {
+			addressA: "192.0.2.1",
+			addressB: "192.0.3.0",
+			output:   "255",
+		},
+		"ipv4_negative": {
+			addressA: "192.0.3.0",
+			addressB: "192.0.2.1",
+			output:   "-255",
+		},
+		"ipv4_same": {
+			addressA: "192.0.2.1",
+			addressB: "192.0.2.1/24",
+			output:   "0",
+		},
+		"ipv4_full": {
+			addressA: "0.0.0.0",
+			addressB: "255.255.255.255",
+			output:   "4294967295",
+		},
+		"ipv6": {
+			addressA: "2001:db8::",
+			addressB: "2001:db8:0:1::",
+			output:   "18446744073709551616",
+		},
+		"ipv6_full": {
+			addressA: "::",
+			addressB: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+			output:   "340282366920938463463374607431768211455",
+		},
+		"different_families": {
+			addressA:    "192.0.2.1",
+			addressB:    "2001:db8::1",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_distance("` + test.addressA + `", "` + test.addressB + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_distance("` + test.addressA + `", "` + test.addressB + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
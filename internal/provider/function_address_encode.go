@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	addressFormatDecimal        = "decimal"
+	addressFormatHex            = "hex"
+	addressFormatHexLE          = "hex_le"
+	addressFormatBinary         = "binary"
+	addressFormatIPv4Mapped     = "ipv4_mapped"
+	addressFormatIPv4Compatible = "ipv4_compatible"
+	addressFormatPlain          = "plain"
+)
+
+var _ function.Function = addressEncodeFunction{}
+
+func newAddressEncodeFunction() function.Function {
+	return addressEncodeFunction{}
+}
+
+type addressEncodeFunction struct{}
+
+func (f addressEncodeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "address_encode"
+}
+
+func (f addressEncodeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Encode an IP address in a specific format.",
+		Description: "Encode an IP address in decimal, hexadecimal (network order or little-endian 32-bit words)" +
+			" or binary format,\n" +
+			" or convert an IPv4 address between IPv4-mapped, IPv4-compatible and plain forms.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "IP address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name: "format",
+				Description: "Output format: `decimal`, `hex`, `hex_le`, `binary`," +
+					" `ipv4_mapped`, `ipv4_compatible` or `plain`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						addressFormatDecimal,
+						addressFormatHex,
+						addressFormatHexLE,
+						addressFormatBinary,
+						addressFormatIPv4Mapped,
+						addressFormatIPv4Compatible,
+						addressFormatPlain,
+					),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f addressEncodeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputAddress, format string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputAddress, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	address, funcErr := parseAddressInput(inputAddress, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	output, ok := addrEncode(address, format)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("must be an IPv4, IPv4-mapped or IPv4-compatible address"+
+				" to encode in "+format+" format"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output))
+}
+
+// addrEncode returns the address encoded in format.
+// It returns false if the address can't be encoded in format
+// (IPv6 address without IPv4 address in ipv4_mapped or ipv4_compatible format).
+func addrEncode(addr netip.Addr, format string) (string, bool) {
+	switch format {
+	case addressFormatDecimal:
+		return addrToBigInt(addr).String(), true
+	case addressFormatHex:
+		return hex.EncodeToString(addr.AsSlice()), true
+	case addressFormatHexLE:
+		return hex.EncodeToString(bytesSwap32(addr.AsSlice())), true
+	case addressFormatBinary:
+		output := addrToBigInt(addr).Text(2)
+
+		return strings.Repeat("0", addr.BitLen()-len(output)) + output, true
+	case addressFormatIPv4Mapped:
+		addr4, ok := addrIPv4Form(addr)
+		if !ok {
+			return "", false
+		}
+
+		return netip.AddrFrom16(addr4.As16()).String(), true
+	case addressFormatIPv4Compatible:
+		addr4, ok := addrIPv4Form(addr)
+		if !ok {
+			return "", false
+		}
+
+		return "::" + addr4.String(), true
+	default:
+		if addr4, ok := addrIPv4Form(addr); ok {
+			return addr4.String(), true
+		}
+
+		return addr.String(), true
+	}
+}
+
+// addrIPv4Form returns the IPv4 address of an IPv4, IPv4-mapped or IPv4-compatible address
+// (::/96 without :: and ::1).
+// It returns false for other addresses.
+func addrIPv4Form(addr netip.Addr) (netip.Addr, bool) {
+	switch {
+	case addr.Is4():
+		return addr, true
+	case addr.Is4In6():
+		return addr.Unmap(), true
+	case netip.MustParsePrefix("::/96").Contains(addr) &&
+		addr != netip.IPv6Unspecified() && addr != netip.IPv6Loopback():
+		b := addr.As16()
+
+		return netip.AddrFrom4([4]byte(b[12:])), true
+	default:
+		return netip.Addr{}, false
+	}
+}
+
+// bytesSwap32 returns a copy of b with the byte order of each 32-bit word reversed.
+func bytesSwap32(b []byte) []byte {
+	swapped := make([]byte, len(b))
+	for i := 0; i+4 <= len(b); i += 4 {
+		swapped[i], swapped[i+1], swapped[i+2], swapped[i+3] = b[i+3], b[i+2], b[i+1], b[i]
+	}
+
+	return swapped
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestAddrEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		addr   netip.Addr
+		format string
+		output string
+	}
+
+	tests := map[string]testCase{
+		"ipv4_decimal": {
+			addr:   netip.MustParseAddr("192.168.2.1"),
+			format: addressFormatDecimal,
+			output: "3232236033",
+		},
+		"ipv4_hex": {
+			addr:   netip.MustParseAddr("192.168.2.1"),
+			format: addressFormatHex,
+			output: "c0a80201",
+		},
+		"ipv4_hex_le": {
+			addr:   netip.MustParseAddr("192.168.2.1"),
+			format: addressFormatHexLE,
+			output: "0102a8c0",
+		},
+		"ipv4_binary": {
+			addr:   netip.MustParseAddr("192.168.2.1"),
+			format: addressFormatBinary,
+			output: "11000000101010000000001000000001",
+		},
+		"ipv4_zero_decimal": {
+			addr:   netip.MustParseAddr("0.0.0.0"),
+			format: addressFormatDecimal,
+			output: "0",
+		},
+		"ipv6_decimal": {
+			addr:   netip.MustParseAddr("2001:db8::1"),
+			format: addressFormatDecimal,
+			output: "42540766411282592856903984951653826561",
+		},
+		"ipv6_hex": {
+			addr:   netip.MustParseAddr("2001:db8::1"),
+			format: addressFormatHex,
+			output: "20010db8000000000000000000000001",
+		},
+		"ipv6_hex_le": {
+			addr:   netip.MustParseAddr("2001:db8::1"),
+			format: addressFormatHexLE,
+			output: "b80d0120000000000000000001000000",
+		},
+		"ipv6_binary": {
+			addr:   netip.MustParseAddr("8000::1"),
+			format: addressFormatBinary,
+			output: "1" +
+				"000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := addrEncode(test.addr, test.format)
+			if !ok {
+				t.Fatalf("got unexpected error to encode %s in %s format", test.addr, test.format)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected encoded value: want %s, got %s", test.output, result)
+			}
+
+			addr, ok := addrDecode(result, test.format, "")
+			if !ok {
+				t.Fatalf("got unexpected error to decode %s in %s format", result, test.format)
+			}
+			if addr != test.addr {
+				t.Errorf("got unexpected decoded address: want %s, got %s", test.addr, addr)
+			}
+		})
+	}
+}
+
+func TestAddrIPv4Form(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		addr   netip.Addr
+		output netip.Addr
+		ok     bool
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			addr:   netip.MustParseAddr("192.0.2.1"),
+			output: netip.MustParseAddr("192.0.2.1"),
+			ok:     true,
+		},
+		"ipv4_mapped": {
+			addr:   netip.MustParseAddr("::ffff:192.0.2.1"),
+			output: netip.MustParseAddr("192.0.2.1"),
+			ok:     true,
+		},
+		"ipv4_compatible": {
+			addr:   netip.MustParseAddr("::192.0.2.1"),
+			output: netip.MustParseAddr("192.0.2.1"),
+			ok:     true,
+		},
+		"unspecified": {
+			addr: netip.MustParseAddr("::"),
+		},
+		"loopback": {
+			addr: netip.MustParseAddr("::1"),
+		},
+		"ipv6": {
+			addr: netip.MustParseAddr("2001:db8::c000:201"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := addrIPv4Form(test.addr)
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected address: want %s, got %s", test.output, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAddressEncode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		address     string
+		format      string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			address:     "",
+			format:      "hex",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_address": {
+			address:     "192.0.2.a",
+			format:      "hex",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"invalid_format": {
+			address:     "192.0.2.1",
+			format:      "octal",
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"ipv4_decimal": {
+			address: "192.168.2.1",
+			format:  "decimal",
+			output:  "3232236033",
+		},
+		"ipv4_hex": {
+			address: "192.168.2.1",
+			format:  "hex",
+			output:  "c0a80201",
+		},
+		"ipv4_hex_le": {
+			address: "192.168.2.1",
+			format:  "hex_le",
+			output:  "0102a8c0",
+		},
+		"ipv4_binary": {
+			address: "192.168.2.1",
+			format:  "binary",
+			output:  "11000000101010000000001000000001",
+		},
+		"ipv4_mapped": {
+			address: "192.0.2.1",
+			format:  "ipv4_mapped",
+			output:  "::ffff:192.0.2.1",
+		},
+		"ipv4_compatible": {
+			address: "192.0.2.1",
+			format:  "ipv4_compatible",
+			output:  "::192.0.2.1",
+		},
+		"ipv4_plain": {
+			address: "192.0.2.1/24",
+			format:  "plain",
+			output:  "192.0.2.1",
+		},
+		"ipv4_mapped_to_compatible": {
+			address: "::ffff:192.0.2.1",
+			format:  "ipv4_compatible",
+			output:  "::192.0.2.1",
+		},
+		"ipv4_mapped_to_plain": {
+			address: "::ffff:192.0.2.1",
+			format:  "plain",
+			output:  "192.0.2.1",
+		},
+		"ipv4_compatible_to_mapped": {
+			address: "::192.0.2.1",
+			format:  "ipv4_mapped",
+			output:  "::ffff:192.0.2.1",
+		},
+		"ipv4_compatible_to_plain": {
+			address: "::192.0.2.1",
+			format:  "plain",
+			output:  "192.0.2.1",
+		},
+		"ipv6_decimal": {
+			address: "2001:db8::1",
+			format:  "decimal",
+			output:  "42540766411282592856903984951653826561",
+		},
+		"ipv6_hex": {
+			address: "2001:db8::1",
+			format:  "hex",
+			output:  "20010db8000000000000000000000001",
+		},
+		"ipv6_hex_le": {
+			address: "2001:db8::1",
+			format:  "hex_le",
+			output:  "b80d0120000000000000000001000000",
+		},
+		"ipv6_binary": {
+			address: "::1",
+			format:  "binary",
+			output: "0000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+		},
+		"ipv6_plain": {
+			address: "2001:db8::1",
+			format:  "plain",
+			output:  "2001:db8::1",
+		},
+		"ipv6_loopback_plain": {
+			address: "::1",
+			format:  "plain",
+			output:  "::1",
+		},
+		"ipv6_mapped": {
+			address:     "2001:db8::1",
+			format:      "ipv4_mapped",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"ipv6_compatible": {
+			address:     "::1",
+			format:      "ipv4_compatible",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_encode("` + test.address + `", "` + test.format + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::address_encode("` + test.address + `", "` + test.format + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = allocateFunction{}
+
+func newAllocateFunction() function.Function {
+	return allocateFunction{}
+}
+
+type allocateFunction struct{}
+
+func (f allocateFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "allocate"
+}
+
+func (f allocateFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Allocate prefixes of variable size in a parent prefix.",
+		Description: "Allocate, for each name, a prefix with the requested prefix length in a parent prefix.\n" +
+			" Allocations of the previous result are kept" +
+			" and new names only take free space of parent prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "parent",
+				Description: "Parent CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.MapParameter{
+				ElementType: types.Int32Type,
+				Name:        "requests",
+				Description: "Map of name to prefix length to allocate",
+			},
+			function.MapParameter{
+				ElementType:    types.StringType,
+				Name:           "previous",
+				Description:    "Map of name to prefix of the previous allocation to keep",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f allocateFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputParent   string
+		inputRequests map[string]int32
+		inputPrevious types.Map
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputParent, &inputRequests, &inputPrevious))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, funcErr := parseCIDRInput(inputParent, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	parent = parent.Masked()
+
+	requests := make(map[string]int, len(inputRequests))
+	for name, bits := range inputRequests {
+		if int(bits) < parent.Bits() || int(bits) > parent.Addr().BitLen() {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(1, "Invalid prefix length"),
+				function.NewFuncError(fmt.Sprintf("prefix length of %q must be between %d and %d for parent %s",
+					name, parent.Bits(), parent.Addr().BitLen(), parent.String())),
+			)
+
+			return
+		}
+		requests[name] = int(bits)
+	}
+
+	previous := make(map[string]netip.Prefix)
+	if !inputPrevious.IsNull() {
+		previousStrings := make(map[string]string)
+		resp.Error = function.FuncErrorFromDiags(ctx, inputPrevious.ElementsAs(ctx, &previousStrings, false))
+		if resp.Error != nil {
+			return
+		}
+
+		for name, inputPrefix := range previousStrings {
+			prefix, funcErr := parseCIDRInput(inputPrefix, 2)
+			if funcErr != nil {
+				resp.Error = funcErr
+
+				return
+			}
+			previous[name] = prefix.Masked()
+		}
+	}
+
+	result, notFit, funcErr := prefixesAllocate(parent, requests, previous)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	if len(notFit) > 0 {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Not enough space in parent"),
+			function.NewFuncError(fmt.Sprintf("unable to allocate %s in %s",
+				strings.Join(notFit, ", "), parent.String())),
+		)
+
+		return
+	}
+
+	output := make(map[string]string, len(result))
+	for name, prefix := range result {
+		output[name] = prefix.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output))
+}
+
+// prefixesAllocate allocates, for each name of requests, a prefix with the requested prefix length in parent
+// and returns the allocations with the sorted names that don't fit in parent.
+//
+// The allocations of previous (for names still requested with the same prefix length) are kept first,
+// so the other names only take the free space and never move the allocations of previous.
+// Then the largest prefixes are allocated first (in alphabetical order of names for the same prefix length),
+// each one in the smallest free prefix large enough (the first in numerical order if several have the same size),
+// so the result doesn't depend on the order of names.
+//
+// It returns an argument error on previous (third argument of allocate function)
+// if kept allocations are not in parent or overlap.
+func prefixesAllocate(
+	parent netip.Prefix, requests map[string]int, previous map[string]netip.Prefix,
+) (map[string]netip.Prefix, []string, *function.FuncError) {
+	// sort names for deterministic processing
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	result := make(map[string]netip.Prefix, len(names))
+	free := []netip.Prefix{parent}
+
+	// keep previous allocations with the same prefix length
+	kept := make([]netip.Prefix, 0, len(previous))
+	newNames := make([]string, 0, len(names))
+	for _, name := range names {
+		prefix, ok := previous[name]
+		if !ok || prefix.Bits() != requests[name] {
+			// new name or prefix length has changed, so allocate a new prefix
+			newNames = append(newNames, name)
+
+			continue
+		}
+		if prefix.Bits() < parent.Bits() || !parent.Contains(prefix.Addr()) {
+			return nil, nil, function.ConcatFuncErrors(
+				function.NewArgumentFuncError(2, "Invalid previous allocation"),
+				function.NewFuncError(fmt.Sprintf("previous prefix %s of %q is not in parent %s",
+					prefix.String(), name, parent.String())),
+			)
+		}
+		for _, v := range kept {
+			if v.Overlaps(prefix) {
+				return nil, nil, function.ConcatFuncErrors(
+					function.NewArgumentFuncError(2, "Invalid previous allocation"),
+					function.NewFuncError(fmt.Sprintf("previous prefix %s of %q overlaps %s",
+						prefix.String(), name, v.String())),
+				)
+			}
+		}
+
+		kept = append(kept, prefix)
+		result[name] = prefix
+		free = freePrefixesAllocate(free, prefix)
+	}
+
+	// allocate the largest prefixes first to limit fragmentation
+	slices.SortStableFunc(newNames, func(a, b string) int {
+		return cmp.Compare(requests[a], requests[b])
+	})
+
+	notFit := make([]string, 0)
+	for _, name := range newNames {
+		prefix := allocateFreePrefix(free, requests[name])
+		if !prefix.IsValid() {
+			notFit = append(notFit, name)
+
+			continue
+		}
+
+		result[name] = prefix
+		free = freePrefixesAllocate(free, prefix)
+	}
+	slices.Sort(notFit)
+
+	return result, notFit, nil
+}
+
+// allocateFreePrefix returns a prefix with prefix length bits at the start of the smallest free prefix
+// large enough in free (the first in numerical order if several have the same size)
+// or an invalid prefix if there is no free prefix large enough.
+func allocateFreePrefix(free []netip.Prefix, bits int) netip.Prefix {
+	bestIndex := -1
+	for i, v := range free {
+		if v.Bits() > bits {
+			continue
+		}
+		if bestIndex == -1 || v.Bits() > free[bestIndex].Bits() {
+			bestIndex = i
+		}
+	}
+	if bestIndex == -1 {
+		return netip.Prefix{}
+	}
+
+	return netip.PrefixFrom(free[bestIndex].Addr(), bits)
+}
+
+// freePrefixesAllocate removes prefix from the list of free prefixes
+// and returns the new list of free prefixes in numerical order.
+func freePrefixesAllocate(free []netip.Prefix, prefix netip.Prefix) []netip.Prefix {
+	newFree := make([]netip.Prefix, 0, len(free)+prefix.Bits())
+	for _, v := range free {
+		newFree = append(newFree, prefixSubtract(v, prefix)...)
+	}
+
+	slices.SortFunc(newFree, func(a, b netip.Prefix) int {
+		return a.Addr().Compare(b.Addr())
+	})
+
+	return newFree
+}
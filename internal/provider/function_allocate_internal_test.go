@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"maps"
+	"net/netip"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestPrefixesAllocate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		requests map[string]int
+		previous map[string]netip.Prefix
+		output   map[string]string
+		notFit   []string
+	}
+
+	parent := netip.MustParsePrefix("10.0.0.0/24")
+
+	tests := map[string]testCase{
+		"packed": {
+			requests: map[string]int{"a": 26, "b": 26, "c": 26},
+			output: map[string]string{
+				"a": "10.0.0.0/26",
+				"b": "10.0.0.64/26",
+				"c": "10.0.0.128/26",
+			},
+		},
+		"largest_first": {
+			requests: map[string]int{"a": 26, "b": 25},
+			output: map[string]string{
+				"a": "10.0.0.128/26",
+				"b": "10.0.0.0/25",
+			},
+		},
+		"smallest_free_prefix": {
+			requests: map[string]int{"a": 27, "b": 26},
+			previous: map[string]netip.Prefix{
+				"b": netip.MustParsePrefix("10.0.0.128/26"),
+			},
+			output: map[string]string{
+				"a": "10.0.0.192/27",
+				"b": "10.0.0.128/26",
+			},
+		},
+		"previous_kept": {
+			requests: map[string]int{"a": 26, "b": 25},
+			previous: map[string]netip.Prefix{
+				"a": netip.MustParsePrefix("10.0.0.0/26"),
+			},
+			output: map[string]string{
+				"a": "10.0.0.0/26",
+				"b": "10.0.0.128/25",
+			},
+		},
+		"previous_length_changed": {
+			requests: map[string]int{"a": 25, "b": 26},
+			previous: map[string]netip.Prefix{
+				"a": netip.MustParsePrefix("10.0.0.0/26"),
+				"b": netip.MustParsePrefix("10.0.0.64/26"),
+			},
+			output: map[string]string{
+				"a": "10.0.0.128/25",
+				"b": "10.0.0.64/26",
+			},
+		},
+		"not_fit": {
+			requests: map[string]int{"a": 25, "b": 25, "d": 26, "c": 26},
+			output: map[string]string{
+				"a": "10.0.0.0/25",
+				"b": "10.0.0.128/25",
+			},
+			notFit: []string{"c", "d"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, notFit, funcErr := prefixesAllocate(parent, test.requests, test.previous)
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Error())
+			}
+
+			output := make(map[string]string, len(result))
+			for k, v := range result {
+				output[k] = v.String()
+			}
+			if !maps.Equal(output, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, output)
+			}
+			if !slices.Equal(notFit, test.notFit) && (len(notFit) != 0 || len(test.notFit) != 0) {
+				t.Errorf("got unexpected not fit: want %v, got %v", test.notFit, notFit)
+			}
+		})
+	}
+}
+
+func TestPrefixesAllocateAddName(t *testing.T) {
+	t.Parallel()
+
+	parent := netip.MustParsePrefix("10.0.0.0/16")
+
+	requests := map[string]int{"app": 24, "db": 24}
+	for i := range 20 {
+		requests["subnet"+strconv.Itoa(i)] = 24 + i%4
+	}
+
+	previous, notFit, funcErr := prefixesAllocate(parent, requests, nil)
+	if funcErr != nil || len(notFit) != 0 {
+		t.Fatalf("unexpected error on first allocation: %v %v", funcErr, notFit)
+	}
+
+	// add larger names, one at a time, and check that existing allocations never move
+	for _, bits := range []int{17, 20, 22, 18} {
+		name := "new" + strconv.Itoa(bits)
+		requests[name] = bits
+
+		result, notFit, funcErr := prefixesAllocate(parent, requests, previous)
+		if funcErr != nil || len(notFit) != 0 {
+			t.Fatalf("unexpected error when adding %s: %v %v", name, funcErr, notFit)
+		}
+		for k, v := range previous {
+			if result[k] != v {
+				t.Errorf("allocation of %s moved when adding %s: want %s, got %s", k, name, v, result[k])
+			}
+		}
+		if !result[name].IsValid() {
+			t.Errorf("%s not allocated", name)
+		}
+
+		previous = result
+	}
+}
@@ -0,0 +1,206 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAllocate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		parent      string
+		requests    string
+		previous    string
+		expectError *regexp.Regexp
+		output      map[string]string
+	}
+
+	tests := map[string]testCase{
+		"empty_parent": {
+			parent:      "",
+			requests:    `{ a = 24 }`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_parent": {
+			parent:      "10.0.0.a/16",
+			requests:    `{ a = 24 }`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"empty_requests": {
+			parent:   "10.0.0.0/16",
+			requests: `{}`,
+			output:   map[string]string{},
+		},
+		"ipv4": {
+			parent:   "10.0.0.0/16",
+			requests: `{ a = 24, b = 20, c = 24, d = 22 }`,
+			output: map[string]string{
+				"a": "10.0.20.0/24",
+				"b": "10.0.0.0/20",
+				"c": "10.0.21.0/24",
+				"d": "10.0.16.0/22",
+			},
+		},
+		"ipv4_parent_not_canonical": {
+			parent:   "10.0.1.1/255.255.255.0",
+			requests: `{ a = 25, b = 26 }`,
+			output: map[string]string{
+				"a": "10.0.1.0/25",
+				"b": "10.0.1.128/26",
+			},
+		},
+		"ipv4_add_name": {
+			parent:   "10.0.0.0/16",
+			requests: `{ a = 24, aa = 18, b = 20, c = 24, d = 22 }`,
+			previous: `{
+				a = "10.0.20.0/24",
+				b = "10.0.0.0/20",
+				c = "10.0.21.0/24",
+				d = "10.0.16.0/22",
+			}`,
+			output: map[string]string{
+				"a":  "10.0.20.0/24",
+				"aa": "10.0.64.0/18",
+				"b":  "10.0.0.0/20",
+				"c":  "10.0.21.0/24",
+				"d":  "10.0.16.0/22",
+			},
+		},
+		"ipv4_keep_previous_fill_gap": {
+			parent:   "10.0.0.0/24",
+			requests: `{ a = 26, b = 26, c = 26 }`,
+			previous: `{
+				a = "10.0.0.0/26",
+				c = "10.0.0.128/26",
+				z = "10.0.0.64/26",
+			}`,
+			output: map[string]string{
+				"a": "10.0.0.0/26",
+				"b": "10.0.0.64/26",
+				"c": "10.0.0.128/26",
+			},
+		},
+		"ipv4_previous_length_changed": {
+			parent:   "10.0.0.0/24",
+			requests: `{ a = 25, b = 26 }`,
+			previous: `{
+				a = "10.0.0.0/26",
+				b = "10.0.0.64/26",
+			}`,
+			output: map[string]string{
+				"a": "10.0.0.128/25",
+				"b": "10.0.0.64/26",
+			},
+		},
+		"ipv4_previous_null": {
+			parent:   "10.0.0.0/24",
+			requests: `{ a = 25, b = 26 }`,
+			previous: `null`,
+			output: map[string]string{
+				"a": "10.0.0.0/25",
+				"b": "10.0.0.128/26",
+			},
+		},
+		"ipv4_previous_not_in_parent": {
+			parent:      "10.0.0.0/24",
+			requests:    `{ a = 25 }`,
+			previous:    `{ a = "10.0.1.0/25" }`,
+			expectError: regexp.MustCompile("Invalid previous allocation"),
+		},
+		"ipv4_previous_overlap": {
+			parent:      "10.0.0.0/24",
+			requests:    `{ a = 25, b = 25 }`,
+			previous:    `{ a = "10.0.0.0/25", b = "10.0.0.0/25" }`,
+			expectError: regexp.MustCompile("Invalid previous allocation"),
+		},
+		"ipv4_previous_invalid": {
+			parent:      "10.0.0.0/24",
+			requests:    `{ a = 25 }`,
+			previous:    `{ a = "10.0.0.a/25" }`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"ipv4_not_enough_space": {
+			parent:      "10.0.0.0/24",
+			requests:    `{ a = 25, b = 25, c = 26, d = 26 }`,
+			expectError: regexp.MustCompile(`unable to allocate c, d in 10.0.0.0/24`),
+		},
+		"ipv4_invalid_length": {
+			parent:      "10.0.0.0/24",
+			requests:    `{ a = 23 }`,
+			expectError: regexp.MustCompile("Invalid prefix length"),
+		},
+		"ipv6": {
+			parent:   "2001:db8::/48",
+			requests: `{ a = 64, b = 56, c = 64 }`,
+			output: map[string]string{
+				"a": "2001:db8:0:100::/64",
+				"b": "2001:db8::/56",
+				"c": "2001:db8:0:101::/64",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			previous := test.previous
+			if previous == "" {
+				previous = `null`
+			}
+			arguments := `"` + test.parent + `", ` + test.requests + `, ` + previous
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::allocate(` + arguments + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make(map[string]knownvalue.Check, len(test.output))
+				for k, v := range test.output {
+					expectedValues[k] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::allocate(` + arguments + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.MapExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -58,6 +58,20 @@ func (f cidrFunction) Run(
 		return
 	}
 
+	output, funcErr := parseCIDRInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// parseCIDRInput parses a CIDR address with completion and replacement/cleanup
+// of incorrect/unwanted data (as cidr function)
+// and returns an argument error with position if the input is invalid.
+func parseCIDRInput(input string, position int64) (netip.Prefix, *function.FuncError) {
 	// split address and mask fields
 	inputAddress, inputMask, _ := strings.Cut(input, "/")
 
@@ -65,9 +79,7 @@ func (f cidrFunction) Run(
 	inputAddress = strings.TrimSpace(inputAddress)
 	inputMask = strings.TrimSpace(inputMask)
 	if len(inputAddress) == 0 {
-		resp.Error = function.NewArgumentFuncError(0, "String only with space character(s)")
-
-		return
+		return netip.Prefix{}, function.NewArgumentFuncError(position, "String only with space character(s)")
 	}
 
 	// remove potential scoped zone
@@ -81,12 +93,10 @@ func (f cidrFunction) Run(
 	// read address part without mask
 	netAddress, err := netip.ParseAddr(inputAddress)
 	if err != nil {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(0, "Invalid CIDR address"),
+		return netip.Prefix{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid CIDR address"),
 			function.NewFuncError("unable to parse address field: "+err.Error()),
 		)
-
-		return
 	}
 
 	var output netip.Prefix
@@ -105,21 +115,24 @@ func (f cidrFunction) Run(
 			// mask in potential address format
 			maskAddr, err := netip.ParseAddr(inputMask)
 			if err != nil {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(0, "Invalid CIDR address"),
+				return netip.Prefix{}, function.ConcatFuncErrors(
+					function.NewArgumentFuncError(position, "Invalid CIDR address"),
 					function.NewFuncError("unable to parse mask field in decimal format: "+err.Error()),
 				)
-
-				return
 			}
 			maskBits, ok := ipAddrToMaskBits(maskAddr)
 			if !ok {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(0, "Invalid CIDR address"),
-					function.NewFuncError("unable to parse mask field in decimal format: invalid octet"),
+				if _, ok := ipAddrMaskOrWildcardToBits(maskAddr); ok {
+					return netip.Prefix{}, function.ConcatFuncErrors(
+						function.NewArgumentFuncError(position, "Invalid CIDR address"),
+						function.NewFuncError("mask "+maskAddr.String()+" is a wildcard mask instead of a netmask"),
+					)
+				}
+
+				return netip.Prefix{}, function.ConcatFuncErrors(
+					function.NewArgumentFuncError(position, "Invalid CIDR address"),
+					function.NewFuncError("mask "+maskAddr.String()+" is not a contiguous netmask or wildcard mask"),
 				)
-
-				return
 			}
 			output = netip.PrefixFrom(netAddress, maskBits)
 
@@ -127,12 +140,10 @@ func (f cidrFunction) Run(
 			var err error
 			output, err = netip.ParsePrefix(netAddress.String() + "/" + inputMask)
 			if err != nil {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(0, "Invalid CIDR address"),
+				return netip.Prefix{}, function.ConcatFuncErrors(
+					function.NewArgumentFuncError(position, "Invalid CIDR address"),
 					function.NewFuncError("unable to parse CIDR address due to mask field: "+err.Error()),
 				)
-
-				return
 			}
 		}
 	case netAddress.Is6():
@@ -148,21 +159,17 @@ func (f cidrFunction) Run(
 		default:
 			output, err = netip.ParsePrefix(netAddress.String() + "/" + inputMask)
 			if err != nil {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(0, "Invalid CIDR address"),
+				return netip.Prefix{}, function.ConcatFuncErrors(
+					function.NewArgumentFuncError(position, "Invalid CIDR address"),
 					function.NewFuncError("unable to parse CIDR address due to mask field: "+err.Error()),
 				)
-
-				return
 			}
 		}
 	default:
 		// if happen, it's a bug
-		resp.Error = function.NewFuncError("Internal Error," +
+		return netip.Prefix{}, function.NewFuncError("Internal Error," +
 			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
-
-		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+	return output, nil
 }
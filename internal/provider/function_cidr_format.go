@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	cidrFormatStyleCIDR     = "cidr"
+	cidrFormatStyleNetmask  = "netmask"
+	cidrFormatStyleWildcard = "wildcard"
+)
+
+var _ function.Function = cidrFormatFunction{}
+
+func newCidrFormatFunction() function.Function {
+	return cidrFormatFunction{}
+}
+
+type cidrFormatFunction struct{}
+
+func (f cidrFormatFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cidr_format"
+}
+
+func (f cidrFormatFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Format a CIDR address with a prefix length, a netmask or a wildcard mask.",
+		Description: "Format a CIDR address with the style `cidr` (address/prefix length)," +
+			" `netmask` (address and netmask separated by a space)" +
+			" or `wildcard` (address and wildcard mask separated by a space).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "style",
+				Description: "Output style: `cidr`, `netmask` or `wildcard`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						cidrFormatStyleCIDR,
+						cidrFormatStyleNetmask,
+						cidrFormatStyleWildcard,
+					),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cidrFormatFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input, style string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &style))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	netmask := maskBitsToIPAddr(prefix.Bits(), prefix.Addr().Is4())
+
+	var output string
+	switch style {
+	case cidrFormatStyleNetmask:
+		output = prefix.Addr().String() + " " + netmask.String()
+	case cidrFormatStyleWildcard:
+		output = prefix.Addr().String() + " " + ipAddrMaskString(ipAddrInvert(netmask))
+	default:
+		output = prefix.Addr().String() + "/" + strconv.Itoa(prefix.Bits())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output))
+}
@@ -0,0 +1,147 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCidrFormat(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		style       string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			style:       "cidr",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid": {
+			input:       "192.0.2.a/24",
+			style:       "cidr",
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"non_contiguous_mask": {
+			input:       "10.0.0.0/255.0.255.0",
+			style:       "wildcard",
+			expectError: regexp.MustCompile(`not\s+a\s+contiguous\s+netmask\s+or\s+wildcard\s+mask`),
+		},
+		"wildcard_mask": {
+			input:       "10.0.0.0/0.0.0.255",
+			style:       "netmask",
+			expectError: regexp.MustCompile(`is\s+a\s+wildcard\s+mask\s+instead\s+of\s+a\s+netmask`),
+		},
+		"invalid_style": {
+			input:       "192.0.2.1/24",
+			style:       "mask",
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"cidr": {
+			input:  "192.0.2.1/255.255.255.0",
+			style:  "cidr",
+			output: "192.0.2.1/24",
+		},
+		"cidr_lenient": {
+			input:  "10/8",
+			style:  "cidr",
+			output: "10.0.0.0/8",
+		},
+		"netmask": {
+			input:  "192.0.2.1/24",
+			style:  "netmask",
+			output: "192.0.2.1 255.255.255.0",
+		},
+		"netmask_host": {
+			input:  "192.0.2.1",
+			style:  "netmask",
+			output: "192.0.2.1 255.255.255.255",
+		},
+		"wildcard": {
+			input:  "192.0.2.1/24",
+			style:  "wildcard",
+			output: "192.0.2.1 0.0.0.255",
+		},
+		"wildcard_netmask": {
+			input:  "10.0.0.0/255.0.0.0",
+			style:  "wildcard",
+			output: "10.0.0.0 0.255.255.255",
+		},
+		"ipv6_cidr": {
+			input:  "2001:db8::1/64",
+			style:  "cidr",
+			output: "2001:db8::1/64",
+		},
+		"ipv6_netmask": {
+			input:  "2001:db8::1/64",
+			style:  "netmask",
+			output: "2001:db8::1 ffff:ffff:ffff:ffff::",
+		},
+		"ipv6_wildcard": {
+			input:  "2001:db8::1/64",
+			style:  "wildcard",
+			output: "2001:db8::1 ::ffff:ffff:ffff:ffff",
+		},
+		"ipv6_wildcard_80": {
+			input:  "2001:db8::1/80",
+			style:  "wildcard",
+			output: "2001:db8::1 ::ffff:ffff:ffff",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::cidr_format("` + test.input + `", "` + test.style + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::cidr_format("` + test.input + `", "` + test.style + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
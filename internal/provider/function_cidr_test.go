@@ -40,6 +40,14 @@ func TestAccFunctionCidr(t *testing.T) {
 			input:       "192.0.2.2/33",
 			expectError: regexp.MustCompile("Invalid CIDR address"),
 		},
+		"invalid_ipv4_mask_non_contiguous": {
+			input:       "192.0.2.2/255.0.255.0",
+			expectError: regexp.MustCompile(`not\s+a\s+contiguous\s+netmask\s+or\s+wildcard\s+mask`),
+		},
+		"invalid_ipv4_mask_wildcard": {
+			input:       "192.0.2.2/0.0.0.255",
+			expectError: regexp.MustCompile(`is\s+a\s+wildcard\s+mask\s+instead\s+of\s+a\s+netmask`),
+		},
 		"address_only_ipv4": {
 			input:  "192.0.2.2",
 			output: "192.0.2.2/32",
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = commonPrefixLengthFunction{}
+
+func newCommonPrefixLengthFunction() function.Function {
+	return commonPrefixLengthFunction{}
+}
+
+type commonPrefixLengthFunction struct{}
+
+func (f commonPrefixLengthFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "common_prefix_length"
+}
+
+func (f commonPrefixLengthFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate the number of leading bits shared by a list of IP addresses and prefixes.",
+		Description: "Return the number of leading bits shared by all IP addresses and prefixes of a list" +
+			" (the prefix length of the result of the supernet function).",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "List of IP addresses and prefixes of the same IP version",
+			},
+		},
+		Return: function.Int32Return{},
+	}
+}
+
+func (f commonPrefixLengthFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	supernet, funcErr := parseSupernetInputs(inputs, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int32(supernet.Bits())))
+}
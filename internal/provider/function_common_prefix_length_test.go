@@ -0,0 +1,150 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCommonPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       []string
+		expectError *regexp.Regexp
+		output      int32
+	}
+
+	tests := map[string]testCase{
+		"ipv4_siblings": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.1.0/24",
+			},
+			output: 23,
+		},
+		"ipv4_addresses": {
+			input: []string{
+				"10.0.0.1",
+				"10.0.0.130",
+				"10.0.0.62",
+			},
+			output: 24,
+		},
+		"ipv4_contained": {
+			input: []string{
+				"10.0.1.0/24",
+				"10.0.0.0/16",
+			},
+			output: 16,
+		},
+		"ipv4_single": {
+			input: []string{
+				"192.0.2.1/24",
+			},
+			output: 24,
+		},
+		"ipv4_all": {
+			input: []string{
+				"0.0.0.0",
+				"255.255.255.255",
+			},
+			output: 0,
+		},
+		"ipv6": {
+			input: []string{
+				"2001:db8::/48",
+				"2001:db8:3::/48",
+			},
+			output: 46,
+		},
+		"ipv6_addresses": {
+			input: []string{
+				"2001:db8::1",
+				"2001:db8::1",
+			},
+			output: 128,
+		},
+		"empty": {
+			input:       []string{},
+			expectError: regexp.MustCompile("Invalid inputs"),
+		},
+		"mixed_families": {
+			input: []string{
+				"10.0.0.0/8",
+				"2001:db8::/32",
+			},
+			expectError: regexp.MustCompile("Invalid inputs"),
+		},
+		"invalid_prefix": {
+			input: []string{
+				"10.0.0.a/8",
+			},
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_address": {
+			input: []string{
+				"10.0.0.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedInput := make([]string, len(test.input))
+			for i, v := range test.input {
+				quotedInput[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::common_prefix_length([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::common_prefix_length([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.Int32Exact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
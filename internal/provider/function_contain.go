@@ -32,9 +32,10 @@ func (f containFunction) Definition(
 ) {
 	resp.Definition = function.Definition{
 		Summary: "Reports whether a prefix contains address(es).",
-		Description: "Reports whether a prefix (container) contains" +
+		Description: "Reports whether a prefix or a range (container) contains" +
 			" an address if address is not in CIDR format or" +
-			" all addresses of address block if address is in CIDR format.",
+			" all addresses of address block if address is in CIDR format or a range.\n" +
+			" Ranges use the start-end notation.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "container",
@@ -66,18 +67,40 @@ func (f containFunction) Run(
 		return
 	}
 
-	container, err := netip.ParsePrefix(inputContainer)
-	if err != nil {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(0, "Invalid CIDR address"),
-			function.NewFuncError("unable to parse container address input: "+err.Error()),
-		)
+	var containerStart, containerEnd netip.Addr
+	if isRangeInput(inputContainer) {
+		var funcErr *function.FuncError
+		containerStart, containerEnd, funcErr = parseRangeInput(inputContainer, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
 
-		return
+			return
+		}
+	} else {
+		container, err := netip.ParsePrefix(inputContainer)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(0, "Invalid CIDR address"),
+				function.NewFuncError("unable to parse container address input: "+err.Error()),
+			)
+
+			return
+		}
+		containerStart = container.Masked().Addr()
+		containerEnd = prefixLastAddr(container)
 	}
 
-	switch strings.Contains(inputAddress, "/") {
-	case true:
+	var addressStart, addressEnd netip.Addr
+	switch {
+	case isRangeInput(inputAddress):
+		var funcErr *function.FuncError
+		addressStart, addressEnd, funcErr = parseRangeInput(inputAddress, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+	case strings.Contains(inputAddress, "/"):
 		address, err := netip.ParsePrefix(inputAddress)
 		if err != nil {
 			resp.Error = function.ConcatFuncErrors(
@@ -87,20 +110,9 @@ func (f containFunction) Run(
 
 			return
 		}
-
-		switch {
-		case container.Addr().BitLen() != address.Addr().BitLen():
-			// reports false if container and address have different IP version
-			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
-		case container.Bits() > address.Bits():
-			// container smaller than address block
-			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
-		case container.Bits() <= address.Bits() && container.Contains(address.Addr()):
-			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, true))
-		default:
-			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
-		}
-	case false:
+		addressStart = address.Masked().Addr()
+		addressEnd = prefixLastAddr(address)
+	default:
 		address, err := netip.ParseAddr(inputAddress)
 		if err != nil {
 			resp.Error = function.ConcatFuncErrors(
@@ -110,7 +122,23 @@ func (f containFunction) Run(
 
 			return
 		}
+		if address.Zone() != "" {
+			// reports false if address has a zone (like netip.Prefix.Contains)
+			resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
+
+			return
+		}
+		addressStart = address
+		addressEnd = address
+	}
 
-		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, container.Contains(address)))
+	switch {
+	case containerStart.BitLen() != addressStart.BitLen():
+		// reports false if container and address have different IP version
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
+	case containerStart.Compare(addressStart) <= 0 && addressEnd.Compare(containerEnd) <= 0:
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, true))
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
 	}
 }
@@ -166,6 +166,51 @@ func TestAccFunctionEqualContains(t *testing.T) {
 			address:   "192.0.2.0",
 			output:    false,
 		},
+		"range_container": {
+			container: "192.0.2.10-192.0.2.20",
+			address:   "192.0.2.15",
+			output:    true,
+		},
+		"range_container_short": {
+			container: "192.0.2.10-20",
+			address:   "192.0.2.21",
+			output:    false,
+		},
+		"range_container_prefix": {
+			container: "192.0.2.0-192.0.2.255",
+			address:   "192.0.2.128/25",
+			output:    true,
+		},
+		"range_address": {
+			container: "192.0.2.0/24",
+			address:   "192.0.2.10-192.0.2.20",
+			output:    true,
+		},
+		"range_address_not": {
+			container: "192.0.2.0/24",
+			address:   "192.0.2.10-192.0.3.20",
+			output:    false,
+		},
+		"range_ipv6": {
+			container: "2001:db8::1-ff",
+			address:   "2001:db8::10-2001:db8::20",
+			output:    true,
+		},
+		"range_ipv4_ipv6": {
+			container: "2001:db8::/64",
+			address:   "192.0.2.10-20",
+			output:    false,
+		},
+		"invalid_range_container": {
+			container:   "192.0.2.20-192.0.2.10",
+			address:     "192.0.2.15",
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_range_address": {
+			container:   "192.0.2.0/24",
+			address:     "192.0.2.10-256",
+			expectError: regexp.MustCompile("Invalid range"),
+		},
 	}
 
 	for name, test := range tests {
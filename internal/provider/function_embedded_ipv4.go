@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	embeddedIPv4SchemeMapped     = "ipv4_mapped"
+	embeddedIPv4SchemeCompatible = "ipv4_compatible"
+	embeddedIPv4SchemeNAT64      = "nat64"
+	embeddedIPv4SchemeNAT64Local = "nat64_local"
+	embeddedIPv4SchemeTeredo     = "teredo"
+	embeddedIPv4Scheme6to4       = "6to4"
+	embeddedIPv4SchemeISATAP     = "isatap"
+)
+
+var _ function.Function = embeddedIPv4Function{}
+
+func newEmbeddedIPv4Function() function.Function {
+	return embeddedIPv4Function{}
+}
+
+type embeddedIPv4Function struct{}
+
+type embeddedIPv4FunctionResult struct {
+	Scheme types.String `tfsdk:"scheme"`
+	IPv4   types.String `tfsdk:"ipv4"`
+	Server types.String `tfsdk:"server"`
+	Port   types.Int32  `tfsdk:"port"`
+}
+
+// embeddedIPv4 is an IPv4 address embedded in an IPv6 address with its embedding scheme.
+type embeddedIPv4 struct {
+	scheme string
+	ipv4   netip.Addr
+	server netip.Addr // only with Teredo
+	port   uint16     // only with Teredo
+}
+
+func (f embeddedIPv4Function) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "embedded_ipv4"
+}
+
+func (f embeddedIPv4Function) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Detect and extract the IPv4 address embedded in an IPv6 address.",
+		Description: "Detect the scheme used to embed an IPv4 address in an IPv6 address" +
+			" (IPv4-mapped, IPv4-compatible, NAT64 with 64:ff9b::/96 or 64:ff9b:1::/48 prefix," +
+			" 6to4, Teredo or ISATAP) and extract the IPv4 address(es).\n" +
+			" Mask of address determines how the IPv4 address is embedded with the 64:ff9b:1::/48 prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "Address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"scheme": types.StringType,
+				"ipv4":   types.StringType,
+				"server": types.StringType,
+				"port":   types.Int32Type,
+			},
+		},
+	}
+}
+
+func (f embeddedIPv4Function) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	var address netip.Prefix
+	switch strings.Contains(input, "/") {
+	case true:
+		var err error
+		address, err = netip.ParsePrefix(input)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(0, "Invalid address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+
+			return
+		}
+	case false:
+		onlyAddress, err := netip.ParseAddr(input)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(0, "Invalid address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+
+			return
+		}
+
+		address = netip.PrefixFrom(onlyAddress.WithZone(""), 96)
+	}
+	if !address.Addr().Is6() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("must be an IPv6 address"),
+		)
+
+		return
+	}
+
+	result := embeddedIPv4FunctionResult{
+		Scheme: types.StringNull(),
+		IPv4:   types.StringNull(),
+		Server: types.StringNull(),
+		Port:   types.Int32Null(),
+	}
+
+	embedded := decodeEmbeddedIPv4(address)
+	if embedded.scheme != "" {
+		if !embedded.ipv4.IsValid() {
+			// if happen, it's a bug
+			resp.Error = function.NewFuncError("Internal Error," +
+				" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+			return
+		}
+
+		result.Scheme = types.StringValue(embedded.scheme)
+		result.IPv4 = types.StringValue(embedded.ipv4.String())
+		if embedded.scheme == embeddedIPv4SchemeTeredo {
+			result.Server = types.StringValue(embedded.server.String())
+			result.Port = types.Int32Value(int32(embedded.port))
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// decodeEmbeddedIPv4 detects the scheme used to embed an IPv4 address in the address of an IPv6 prefix
+// and returns the embedded IPv4 address(es) or an empty scheme if no scheme is detected.
+//
+// The schemes are detected in this order:
+//   - IPv4-mapped (::ffff:0:0/96), RFC 4291 section 2.5.5.2
+//   - IPv4-compatible (::/96 except :: and ::1), RFC 4291 section 2.5.5.1
+//   - NAT64 with the well-known prefix (64:ff9b::/96), RFC 6052 section 2.1
+//   - NAT64 with the local-use prefix (64:ff9b:1::/48), RFC 8215,
+//     with the prefix length (at least /48, otherwise /96) to determine how the IPv4 address is embedded
+//   - Teredo (2001::/32) with server, obfuscated client port and address, RFC 4380 section 4
+//   - 6to4 (2002::/16), RFC 3056 section 2
+//   - ISATAP interface identifier (0000:5efe or 0200:5efe), RFC 5214 section 6.1.
+func decodeEmbeddedIPv4(address netip.Prefix) embeddedIPv4 {
+	addr := address.Addr().WithZone("")
+	if !addr.Is6() {
+		return embeddedIPv4{}
+	}
+
+	b := addr.As16()
+	switch {
+	case addr.Is4In6():
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeMapped,
+			ipv4:   addr.Unmap(),
+		}
+	case netip.MustParsePrefix("::/96").Contains(addr) && !addr.IsUnspecified() && !addr.IsLoopback():
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeCompatible,
+			ipv4:   netip.AddrFrom4([4]byte(b[12:16])),
+		}
+	case netip.MustParsePrefix("64:ff9b::/96").Contains(addr):
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeNAT64,
+			ipv4:   translateAddress6to4(netip.PrefixFrom(addr, 96)),
+		}
+	case netip.MustParsePrefix("64:ff9b:1::/48").Contains(addr):
+		bits := address.Bits()
+		if bits < 48 {
+			bits = 96
+		}
+
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeNAT64Local,
+			ipv4:   translateAddress6to4(netip.PrefixFrom(addr, bits)),
+		}
+	case netip.MustParsePrefix("2001::/32").Contains(addr):
+		// client port and address are obfuscated by inverting all bits
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeTeredo,
+			ipv4:   netip.AddrFrom4([4]byte{^b[12], ^b[13], ^b[14], ^b[15]}),
+			server: netip.AddrFrom4([4]byte(b[4:8])),
+			port:   ^(uint16(b[10])<<8 | uint16(b[11])),
+		}
+	case netip.MustParsePrefix("2002::/16").Contains(addr):
+		return embeddedIPv4{
+			scheme: embeddedIPv4Scheme6to4,
+			ipv4:   netip.AddrFrom4([4]byte(b[2:6])),
+		}
+	case b[8]|0x02 == 0x02 && b[9] == 0x00 && b[10] == 0x5e && b[11] == 0xfe:
+		return embeddedIPv4{
+			scheme: embeddedIPv4SchemeISATAP,
+			ipv4:   netip.AddrFrom4([4]byte(b[12:16])),
+		}
+	default:
+		return embeddedIPv4{}
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestDecodeEmbeddedIPv4(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input  netip.Prefix
+		output embeddedIPv4
+	}
+
+	tests := map[string]testCase{
+		"ipv4_mapped": {
+			input: netip.MustParsePrefix("::ffff:192.0.2.33/96"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeMapped,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"ipv4_compatible": {
+			input: netip.MustParsePrefix("::c000:221/96"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeCompatible,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"unspecified": {
+			input: netip.MustParsePrefix("::/96"),
+		},
+		"nat64_mask_ignored": {
+			input: netip.MustParsePrefix("64:ff9b::c000:221/32"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeNAT64,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"nat64_local_64": {
+			input: netip.MustParsePrefix("64:ff9b:1:0:c0:2:2100:0/64"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeNAT64Local,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"nat64_local_too_short_mask": {
+			input: netip.MustParsePrefix("64:ff9b:1::c000:221/32"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeNAT64Local,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"teredo": {
+			input: netip.MustParsePrefix("2001:0:4136:e378:8000:63bf:3fff:fdd2/96"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeTeredo,
+				ipv4:   netip.MustParseAddr("192.0.2.45"),
+				server: netip.MustParseAddr("65.54.227.120"),
+				port:   40000,
+			},
+		},
+		"6to4_with_isatap": {
+			input: netip.MustParsePrefix("2002:c000:221:1:0:5efe:c633:6401/96"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4Scheme6to4,
+				ipv4:   netip.MustParseAddr("192.0.2.33"),
+			},
+		},
+		"isatap": {
+			input: netip.MustParsePrefix("fe80::200:5efe:c633:6401/96"),
+			output: embeddedIPv4{
+				scheme: embeddedIPv4SchemeISATAP,
+				ipv4:   netip.MustParseAddr("198.51.100.1"),
+			},
+		},
+		"not_isatap_group_bit": {
+			input: netip.MustParsePrefix("fe80::100:5efe:c633:6401/96"),
+		},
+		"ipv4": {
+			input: netip.MustParsePrefix("192.0.2.33/32"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := decodeEmbeddedIPv4(test.input)
+			if output != test.output {
+				t.Errorf("got unexpected result: want %+v, got %+v", test.output, output)
+			}
+		})
+	}
+}
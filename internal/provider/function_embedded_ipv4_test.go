@@ -0,0 +1,200 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionEmbeddedIPv4(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      map[string]knownvalue.Check
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("value must be at least 1 characters"),
+		},
+		"ipv4_mapped": {
+			input: "::ffff:192.0.2.33",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("ipv4_mapped"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"ipv4_compatible": {
+			input: "::c000:221",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("ipv4_compatible"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"loopback": {
+			input: "::1",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.Null(),
+				"ipv4":   knownvalue.Null(),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"nat64": {
+			input: "64:ff9b::192.0.2.33",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("nat64"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"nat64_with_mask": {
+			input: "64:ff9b::c000:221/64",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("nat64"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"nat64_local": {
+			input: "64:ff9b:1::c000:221",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("nat64_local"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"nat64_local_56": {
+			input: "64:ff9b:1:c0:0:221::/56",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("nat64_local"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"nat64_local_48": {
+			input: "64:ff9b:1:c000:2:2100::/48",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("nat64_local"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"teredo": {
+			input: "2001:0:4136:e378:8000:63bf:3fff:fdd2",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("teredo"),
+				"ipv4":   knownvalue.StringExact("192.0.2.45"),
+				"server": knownvalue.StringExact("65.54.227.120"),
+				"port":   knownvalue.Int32Exact(40000),
+			},
+		},
+		"6to4": {
+			input: "2002:c000:221::1",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("6to4"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"isatap_link_local": {
+			input: "fe80::5efe:c000:221%eth0",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("isatap"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"isatap_global": {
+			input: "2001:db8::200:5efe:c000:221/64",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.StringExact("isatap"),
+				"ipv4":   knownvalue.StringExact("192.0.2.33"),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"none": {
+			input: "2001:db8::1",
+			output: map[string]knownvalue.Check{
+				"scheme": knownvalue.Null(),
+				"ipv4":   knownvalue.Null(),
+				"server": knownvalue.Null(),
+				"port":   knownvalue.Null(),
+			},
+		},
+		"ipv4": {
+			input:       "192.0.2.1",
+			expectError: regexp.MustCompile("must be an IPv6 address"),
+		},
+		"invalid": {
+			input:       "2001:db8::zz",
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::embedded_ipv4("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::embedded_ipv4("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ObjectExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = excludeFunction{}
+
+func newExcludeFunction() function.Function {
+	return excludeFunction{}
+}
+
+type excludeFunction struct{}
+
+func (f excludeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "exclude"
+}
+
+func (f excludeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Exclude IP addresses and prefixes from IP prefixes.",
+		Description: "Exclude a set of IP addresses and prefixes from a set of IP addresses and prefixes" +
+			" and return the smallest possible list of prefixes that cover the remaining addresses.",
+		Parameters: []function.Parameter{
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "prefixes",
+				Description: "Set of IP addresses and prefixes to exclude from",
+			},
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "excluded",
+				Description: "Set of IP addresses and prefixes to exclude",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f excludeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputPrefixes, inputExcluded []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputPrefixes, &inputExcluded))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(inputPrefixes))
+	for _, item := range inputPrefixes {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	excluded := make([]netip.Prefix, 0, len(inputExcluded))
+	for _, item := range inputExcluded {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		excluded = append(excluded, prefix)
+	}
+
+	remaining := prefixesExclude(prefixes, excluded)
+
+	result := make([]string, len(remaining))
+	for i, p := range remaining {
+		result[i] = p.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// prefixesExclude returns the minimal list of prefixes that covers
+// the IP space of prefixes without the IP space of excluded.
+func prefixesExclude(prefixes, excluded []netip.Prefix) []netip.Prefix {
+	// summarize to have sorted and non-overlapping prefixes
+	prefixes = prefixesSummarize(prefixes)
+	excluded = prefixesSummarize(excluded)
+
+	remaining := make([]netip.Prefix, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		// remaining range of prefix is [start, end]
+		start := prefix.Addr()
+		end := prefixLastAddr(prefix)
+		fullyExcluded := false
+
+		for _, exclude := range excluded {
+			if !prefix.Overlaps(exclude) {
+				// also happen when prefix and exclude have different IP version
+				continue
+			}
+
+			excludeStart := exclude.Addr()
+			excludeEnd := prefixLastAddr(exclude)
+			if excludeStart.Compare(start) > 0 {
+				remaining = append(remaining, rangeToPrefixes(start, excludeStart.Prev())...)
+			}
+			if excludeEnd.Compare(end) >= 0 {
+				fullyExcluded = true
+
+				break
+			}
+
+			start = excludeEnd.Next()
+		}
+
+		if !fullyExcluded {
+			remaining = append(remaining, rangeToPrefixes(start, end)...)
+		}
+	}
+
+	return prefixesSummarize(remaining)
+}
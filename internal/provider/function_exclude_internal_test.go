@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixesExclude(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes []netip.Prefix
+		excluded []netip.Prefix
+		output   []netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixes: []netip.Prefix{},
+			excluded: []netip.Prefix{},
+			output:   []netip.Prefix{},
+		},
+		"nothing_excluded": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+			},
+			excluded: []netip.Prefix{},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/23"),
+			},
+		},
+		"ipv4_middle": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.64/26"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/26"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+			},
+		},
+		"ipv4_several_excluded": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.128/25"),
+				netip.MustParsePrefix("10.0.0.0/26"),
+				netip.MustParsePrefix("10.0.0.64/32"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.65/32"),
+				netip.MustParsePrefix("10.0.0.66/31"),
+				netip.MustParsePrefix("10.0.0.68/30"),
+				netip.MustParsePrefix("10.0.0.72/29"),
+				netip.MustParsePrefix("10.0.0.80/28"),
+				netip.MustParsePrefix("10.0.0.96/27"),
+			},
+		},
+		"ipv4_overlapping_excluded": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/25"),
+				netip.MustParsePrefix("10.0.0.0/26"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.128/25"),
+			},
+		},
+		"ipv4_excluded_contains_prefix": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("192.0.2.0/24"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/8"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+			},
+		},
+		"ipv4_max": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("255.255.255.0/24"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("255.255.255.0/25"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("255.255.255.128/25"),
+			},
+		},
+		"ipv6": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/62"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8:0:1::/64"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/64"),
+				netip.MustParsePrefix("2001:db8:0:2::/63"),
+			},
+		},
+		"mixed_families": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/63"),
+				netip.MustParsePrefix("10.0.0.0/23"),
+			},
+			excluded: []netip.Prefix{
+				netip.MustParsePrefix("::/0"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixesExclude(test.prefixes, test.excluded)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionExclude(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes    []string
+		excluded    []string
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"ipv4_half": {
+			prefixes: []string{
+				"192.0.2.0/24",
+			},
+			excluded: []string{
+				"192.0.2.0/25",
+			},
+			output: []string{
+				"192.0.2.128/25",
+			},
+		},
+		"ipv4_address": {
+			prefixes: []string{
+				"192.0.2.0/29",
+			},
+			excluded: []string{
+				"192.0.2.3",
+			},
+			output: []string{
+				"192.0.2.0/31",
+				"192.0.2.2/32",
+				"192.0.2.4/30",
+			},
+		},
+		"ipv4_rfc1918": {
+			prefixes: []string{
+				"0.0.0.0/0",
+			},
+			excluded: []string{
+				"10.0.0.0/8",
+				"172.16.0.0/12",
+				"192.168.0.0/16",
+			},
+			output: []string{
+				"0.0.0.0/5",
+				"8.0.0.0/7",
+				"11.0.0.0/8",
+				"12.0.0.0/6",
+				"16.0.0.0/4",
+				"32.0.0.0/3",
+				"64.0.0.0/2",
+				"128.0.0.0/3",
+				"160.0.0.0/5",
+				"168.0.0.0/6",
+				"172.0.0.0/12",
+				"172.32.0.0/11",
+				"172.64.0.0/10",
+				"172.128.0.0/9",
+				"173.0.0.0/8",
+				"174.0.0.0/7",
+				"176.0.0.0/4",
+				"192.0.0.0/9",
+				"192.128.0.0/11",
+				"192.160.0.0/13",
+				"192.169.0.0/16",
+				"192.170.0.0/15",
+				"192.172.0.0/14",
+				"192.176.0.0/12",
+				"192.192.0.0/10",
+				"193.0.0.0/8",
+				"194.0.0.0/7",
+				"196.0.0.0/6",
+				"200.0.0.0/5",
+				"208.0.0.0/4",
+				"224.0.0.0/3",
+			},
+		},
+		"ipv4_all_excluded": {
+			prefixes: []string{
+				"192.0.2.0/24",
+			},
+			excluded: []string{
+				"192.0.2.0/25",
+				"192.0.2.128/25",
+			},
+			output: []string{},
+		},
+		"ipv4_larger_excluded": {
+			prefixes: []string{
+				"192.0.2.0/24",
+			},
+			excluded: []string{
+				"192.0.0.0/16",
+			},
+			output: []string{},
+		},
+		"ipv4_no_overlap": {
+			prefixes: []string{
+				"192.0.2.0/24",
+				"192.0.3.0/24",
+			},
+			excluded: []string{
+				"10.0.0.0/8",
+			},
+			output: []string{
+				"192.0.2.0/23",
+			},
+		},
+		"empty_excluded": {
+			prefixes: []string{
+				"192.0.2.0/24",
+			},
+			excluded: []string{},
+			output: []string{
+				"192.0.2.0/24",
+			},
+		},
+		"empty_prefixes": {
+			prefixes: []string{},
+			excluded: []string{
+				"192.0.2.0/24",
+			},
+			output: []string{},
+		},
+		"ipv6": {
+			prefixes: []string{
+				"2001:db8::/46",
+			},
+			excluded: []string{
+				"2001:db8:1::/48",
+			},
+			output: []string{
+				"2001:db8::/48",
+				"2001:db8:2::/47",
+			},
+		},
+		"mixed_families": {
+			prefixes: []string{
+				"0.0.0.0/0",
+				"::/0",
+			},
+			excluded: []string{
+				"0.0.0.0/1",
+				"::/1",
+			},
+			output: []string{
+				"128.0.0.0/1",
+				"8000::/1",
+			},
+		},
+		"different_families": {
+			prefixes: []string{
+				"::/0",
+			},
+			excluded: []string{
+				"0.0.0.0/0",
+			},
+			output: []string{
+				"::/0",
+			},
+		},
+		"invalid_prefixes": {
+			prefixes: []string{
+				"192.0.2.a/24",
+			},
+			excluded:    []string{},
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_excluded": {
+			prefixes: []string{
+				"192.0.2.0/24",
+			},
+			excluded: []string{
+				"192.0.2.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedPrefixes := make([]string, len(test.prefixes))
+			for i, v := range test.prefixes {
+				quotedPrefixes[i] = fmt.Sprintf("%q", v)
+			}
+			quotedExcluded := make([]string, len(test.excluded))
+			for i, v := range test.excluded {
+				quotedExcluded[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::exclude(
+									toset([` + strings.Join(quotedPrefixes, ", ") + `]),
+									toset([` + strings.Join(quotedExcluded, ", ") + `]),
+								)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::exclude(
+									toset([` + strings.Join(quotedPrefixes, ", ") + `]),
+									toset([` + strings.Join(quotedExcluded, ", ") + `]),
+								)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = extractMACFunction{}
+
+func newExtractMACFunction() function.Function {
+	return extractMACFunction{}
+}
+
+type extractMACFunction struct{}
+
+func (f extractMACFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "extract_mac"
+}
+
+func (f extractMACFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Extract the MAC address from an IPv6 address with the modified EUI-64 format.",
+		Description: "Extract the MAC address from the interface identifier of an IPv6 address" +
+			" with the modified EUI-64 format, as defined in RFC 4291 section 2.5.1" +
+			" (inverse of generate6_eui64 function).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "IPv6 address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f extractMACFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	address, funcErr := parseAddressInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	if !address.Is6() || address.Is4In6() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("address must be an IPv6 address"),
+		)
+
+		return
+	}
+
+	mac, ok := extractMACFromIPv6AddressEUI64(address)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("interface identifier of "+address.String()+
+				" is not in modified EUI-64 format (missing ff:fe in the middle)"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mac.String()))
+}
+
+// extractMACFromIPv6AddressEUI64 returns the MAC address of the interface identifier
+// of address in modified EUI-64 format (inverse of computeIPv6AddressEUI64).
+// It returns false if address is not an IPv6 address
+// or if the interface identifier doesn't have the ff:fe marker in the middle.
+func extractMACFromIPv6AddressEUI64(address netip.Addr) (net.HardwareAddr, bool) {
+	if !address.Is6() || address.Is4In6() {
+		return nil, false
+	}
+
+	b := address.As16()
+	if b[11] != 0xff || b[12] != 0xfe {
+		return nil, false
+	}
+
+	mac := make(net.HardwareAddr, 6)
+	// copy first part of mac
+	copy(mac[0:3], b[8:11])
+	// revert the "u" bit
+	mac[0] ^= 0x02
+	// copy second part of mac
+	copy(mac[3:6], b[13:16])
+
+	return mac, true
+}
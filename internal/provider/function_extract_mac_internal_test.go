@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestExtractMACFromIPv6AddressEUI64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input  string
+		ok     bool
+		output string
+	}
+
+	tests := map[string]testCase{
+		"link_local": {
+			input:  "fe80::200:5eff:fe00:5300",
+			ok:     true,
+			output: "00:00:5e:00:53:00",
+		},
+		"global_local_bit": {
+			input:  "2001:db8::ff:fe00:1",
+			ok:     true,
+			output: "02:00:00:00:00:01",
+		},
+		"not_eui64": {
+			input: "2001:db8::1",
+		},
+		"reverse_marker": {
+			input: "2001:db8::200:5efe:ff00:5300",
+		},
+		"ipv4": {
+			input: "192.0.2.1",
+		},
+		"ipv4_mapped": {
+			input: "::ffff:254.0.2.1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mac, ok := extractMACFromIPv6AddressEUI64(netip.MustParseAddr(test.input))
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if !ok {
+				return
+			}
+			if mac.String() != test.output {
+				t.Errorf("got unexpected result: want %s, got %s", test.output, mac)
+			}
+			if rev := computeIPv6AddressEUI64(netip.MustParseAddr(test.input), mac); rev.String() != test.input {
+				t.Errorf("got unexpected reverse result: want %s, got %s", test.input, rev)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionExtractMAC(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("value must be at least 1 characters"),
+		},
+		"link_local": {
+			input:  "fe80::200:5eff:fe00:5300",
+			output: "00:00:5e:00:53:00",
+		},
+		"with_zone_and_mask": {
+			input:  "fe80::200:5eff:fe53:5300%eth0/64",
+			output: "00:00:5e:53:53:00",
+		},
+		"global_uppercase": {
+			input:  "2001:DB8::2AA:FF:FE28:9C5A",
+			output: "00:aa:00:28:9c:5a",
+		},
+		"ipv4": {
+			input:       "192.0.2.1",
+			expectError: regexp.MustCompile("must be an IPv6 address"),
+		},
+		"not_eui64": {
+			input:       "2001:db8::1",
+			expectError: regexp.MustCompile("is not in modified EUI-64 format"),
+		},
+		"invalid": {
+			input:       "fe80::200:5eff:fe00:5300:1:2",
+			expectError: regexp.MustCompile("unable to parse address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::extract_mac("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::extract_mac("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	format6OptionUppercase    = "uppercase"
+	format6OptionZeroPad      = "zero_pad"
+	format6OptionNoCompress   = "no_compress"
+	format6OptionIPv4Embedded = "ipv4_embedded"
+	format6OptionDropZone     = "drop_zone"
+)
+
+var _ function.Function = format6Function{}
+
+func newFormat6Function() function.Function {
+	return format6Function{}
+}
+
+type format6Function struct{}
+
+type format6Options struct {
+	uppercase    bool
+	zeroPad      bool
+	noCompress   bool
+	ipv4Embedded bool
+	dropZone     bool
+}
+
+func (f format6Function) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "format6"
+}
+
+func (f format6Function) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Format IPv6 address (CIDR or not) with options.",
+		Description: "Format IPv6 address, with CIDR format or not, to the canonical text representation" +
+			" defined in RFC 5952 section 4, altered by options: " +
+			format6OptionUppercase + ", " +
+			format6OptionZeroPad + ", " +
+			format6OptionNoCompress + ", " +
+			format6OptionIPv4Embedded + " and " +
+			format6OptionDropZone + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "Address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "options",
+				Description: "(Optional) Set of options to alter the canonical format: " +
+					"`" + format6OptionUppercase + "`, " +
+					"`" + format6OptionZeroPad + "`, " +
+					"`" + format6OptionNoCompress + "`, " +
+					"`" + format6OptionIPv4Embedded + "`, " +
+					"`" + format6OptionDropZone + "`",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f format6Function) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		input        string
+		inputOptions []string
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &inputOptions))
+	if resp.Error != nil {
+		return
+	}
+
+	var options format6Options
+	for _, option := range inputOptions {
+		switch option {
+		case format6OptionUppercase:
+			options.uppercase = true
+		case format6OptionZeroPad:
+			options.zeroPad = true
+		case format6OptionNoCompress:
+			options.noCompress = true
+		case format6OptionIPv4Embedded:
+			options.ipv4Embedded = true
+		case format6OptionDropZone:
+			options.dropZone = true
+		default:
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(1, "Invalid option"),
+				function.NewFuncError("unknown option "+strconv.Quote(option)+", must be one of "+
+					strings.Join([]string{
+						format6OptionUppercase,
+						format6OptionZeroPad,
+						format6OptionNoCompress,
+						format6OptionIPv4Embedded,
+						format6OptionDropZone,
+					}, ", ")),
+			)
+
+			return
+		}
+	}
+
+	switch strings.Contains(input, "/") {
+	case true:
+		output, err := netip.ParsePrefix(input)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(0, "Invalid CIDR address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+
+			return
+		}
+
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx,
+			formatAddress6(output.Addr(), options)+"/"+strconv.Itoa(output.Bits())))
+
+	case false:
+		output, err := netip.ParseAddr(input)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(0, "Invalid address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+
+			return
+		}
+
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, formatAddress6(output, options)))
+	}
+}
+
+// formatAddress6 returns the text representation of an IPv6 address
+// in the canonical format defined in RFC 5952 section 4 altered by options:
+//   - uppercase: hexadecimal digits in uppercase
+//   - zeroPad: leading zeros of each 16-bit group are not suppressed
+//   - noCompress: the longest run of consecutive 16-bit zero groups is not compressed with '::'
+//   - ipv4Embedded: the last 32 bits in dotted decimal format
+//     for an IPv4-mapped address (::ffff:0:0/96) or an address with the RFC 6052 well-known prefix (64:ff9b::/96)
+//   - dropZone: the scoped zone is removed
+//
+// An IPv4 address is returned in its standard format.
+func formatAddress6(address netip.Addr, options format6Options) string {
+	if !address.Is6() {
+		return address.String()
+	}
+
+	b := address.As16()
+
+	groupsCount := 8
+	embedded := options.ipv4Embedded &&
+		(address.Is4In6() || netip.MustParsePrefix("64:ff9b::/96").Contains(address.WithZone("")))
+	if embedded {
+		groupsCount = 6
+	}
+
+	groups := make([]uint16, groupsCount)
+	for i := range groups {
+		groups[i] = uint16(b[i*2])<<8 | uint16(b[i*2+1])
+	}
+
+	zeroStart, zeroEnd := -1, -1
+	if !options.noCompress {
+		zeroStart, zeroEnd = longestZeroGroupsRun(groups)
+	}
+
+	var output strings.Builder
+	for i := 0; i < len(groups); i++ {
+		if i == zeroStart {
+			_, _ = output.WriteString("::")
+			i = zeroEnd - 1
+
+			continue
+		}
+		if i > 0 && i != zeroEnd {
+			_ = output.WriteByte(':')
+		}
+		group := strconv.FormatUint(uint64(groups[i]), 16)
+		if options.zeroPad {
+			group = strings.Repeat("0", 4-len(group)) + group
+		}
+		_, _ = output.WriteString(group)
+	}
+	if embedded {
+		if zeroEnd != len(groups) {
+			_ = output.WriteByte(':')
+		}
+		_, _ = output.WriteString(netip.AddrFrom4([4]byte(b[12:16])).String())
+	}
+
+	result := output.String()
+	if options.uppercase {
+		result = strings.ToUpper(result)
+	}
+	if zone := address.Zone(); zone != "" && !options.dropZone {
+		result += "%" + zone
+	}
+
+	return result
+}
+
+// longestZeroGroupsRun returns the start (inclusive) and the end (exclusive) indexes
+// of the longest run of at least two zero groups (the first one if several have the same length)
+// or -1, -1 if there is no such run.
+func longestZeroGroupsRun(groups []uint16) (int, int) {
+	start, end := -1, -1
+	for i := 0; i < len(groups); {
+		if groups[i] != 0 {
+			i++
+
+			continue
+		}
+		j := i
+		for j < len(groups) && groups[j] == 0 {
+			j++
+		}
+		if j-i >= 2 && j-i > end-start {
+			start, end = i, j
+		}
+		i = j
+	}
+
+	return start, end
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestFormatAddress6(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input   string
+		options format6Options
+		output  string
+	}
+
+	tests := map[string]testCase{
+		// examples of RFC 5952 section 4
+		"leading_zeros": {
+			input:  "2001:0db8::0001",
+			output: "2001:db8::1",
+		},
+		"longest_run": {
+			input:  "2001:db8:0:0:1:0:0:1",
+			output: "2001:db8::1:0:0:1",
+		},
+		"first_run_when_equal": {
+			input:  "2001:0:0:1:0:0:0:1",
+			output: "2001:0:0:1::1",
+		},
+		"no_compress_single_group": {
+			input:  "2001:db8:0:1:1:1:1:1",
+			output: "2001:db8:0:1:1:1:1:1",
+		},
+		"all_zeros": {
+			input:  "::",
+			output: "::",
+		},
+		"trailing_run": {
+			input:  "2001:db8::",
+			output: "2001:db8::",
+		},
+		"all_options": {
+			input: "64:ff9b::c000:221%eth0",
+			options: format6Options{
+				uppercase:    true,
+				zeroPad:      true,
+				noCompress:   true,
+				ipv4Embedded: true,
+				dropZone:     true,
+			},
+			output: "0064:FF9B:0000:0000:0000:0000:192.0.2.33",
+		},
+		"ipv4_embedded_no_run": {
+			input:   "64:ff9b::c000:221",
+			options: format6Options{ipv4Embedded: true, noCompress: true},
+			output:  "64:ff9b:0:0:0:0:192.0.2.33",
+		},
+		"ipv4_embedded_zone": {
+			input:   "::ffff:c000:221%eth0",
+			options: format6Options{ipv4Embedded: true},
+			output:  "::ffff:192.0.2.33%eth0",
+		},
+		"ipv4": {
+			input:   "192.0.2.1",
+			options: format6Options{zeroPad: true},
+			output:  "192.0.2.1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := formatAddress6(netip.MustParseAddr(test.input), test.options)
+			if output != test.output {
+				t.Errorf("got unexpected result: want %s, got %s", test.output, output)
+			}
+		})
+	}
+}
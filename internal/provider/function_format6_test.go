@@ -0,0 +1,162 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionFormat6(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		options     string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			options:     `null`,
+			expectError: regexp.MustCompile("value must be at least 1 characters"),
+		},
+		"canonical": {
+			input:   "2001:DB8:0:0:1:0:0:1",
+			options: `null`,
+			output:  "2001:db8::1:0:0:1",
+		},
+		"canonical_cidr": {
+			input:   "2001:db8:0:0:1:0:0:1/64",
+			options: `[]`,
+			output:  "2001:db8::1:0:0:1/64",
+		},
+		"canonical_single_zero_group": {
+			input:   "2001:db8:0:1:1:1:1:1",
+			options: `null`,
+			output:  "2001:db8:0:1:1:1:1:1",
+		},
+		"canonical_mapped": {
+			input:   "::ffff:192.0.2.33",
+			options: `null`,
+			output:  "::ffff:c000:221",
+		},
+		"uppercase": {
+			input:   "2001:db8::abcd:1",
+			options: `["uppercase"]`,
+			output:  "2001:DB8::ABCD:1",
+		},
+		"zero_pad": {
+			input:   "2001:db8::1/64",
+			options: `["zero_pad"]`,
+			output:  "2001:0db8::0001/64",
+		},
+		"no_compress": {
+			input:   "2001:db8::1",
+			options: `["no_compress"]`,
+			output:  "2001:db8:0:0:0:0:0:1",
+		},
+		"zero_pad_no_compress": {
+			input:   "2001:db8::1",
+			options: `["zero_pad", "no_compress"]`,
+			output:  "2001:0db8:0000:0000:0000:0000:0000:0001",
+		},
+		"ipv4_embedded_nat64": {
+			input:   "64:ff9b::c000:221",
+			options: `["ipv4_embedded"]`,
+			output:  "64:ff9b::192.0.2.33",
+		},
+		"ipv4_embedded_mapped": {
+			input:   "::ffff:c000:221",
+			options: `["ipv4_embedded", "zero_pad"]`,
+			output:  "::ffff:192.0.2.33",
+		},
+		"ipv4_embedded_other": {
+			input:   "2001:db8::c000:221",
+			options: `["ipv4_embedded"]`,
+			output:  "2001:db8::c000:221",
+		},
+		"zone": {
+			input:   "fe80::ABCD:1%Eth0",
+			options: `["uppercase"]`,
+			output:  "FE80::ABCD:1%Eth0",
+		},
+		"drop_zone": {
+			input:   "fe80::abcd:1%eth0",
+			options: `["drop_zone"]`,
+			output:  "fe80::abcd:1",
+		},
+		"ipv4": {
+			input:   "192.0.2.1",
+			options: `["uppercase"]`,
+			output:  "192.0.2.1",
+		},
+		"invalid_option": {
+			input:       "2001:db8::1",
+			options:     "[\"lowercase\"]",
+			expectError: regexp.MustCompile("Invalid option"),
+		},
+		"invalid_address": {
+			input:       "2001:db8::zz",
+			options:     `null`,
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"invalid_cidr": {
+			input:       "2001:db8::1/129",
+			options:     `null`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::format6("` + test.input + `", ` + test.options + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::format6("` + test.input + `", ` + test.options + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
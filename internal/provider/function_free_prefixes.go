@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = freePrefixesFunction{}
+
+func newFreePrefixesFunction() function.Function {
+	return freePrefixesFunction{}
+}
+
+type freePrefixesFunction struct{}
+
+// freePrefixesMaxSplit is the maximum number of free prefixes with a specific prefix length
+// to prevent an unexpected huge list (e.g. a free IPv6 /64 split into /128).
+const freePrefixesMaxSplit = 65536
+
+func (f freePrefixesFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "free_prefixes"
+}
+
+func (f freePrefixesFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Find free address space in a parent prefix.",
+		Description: "Return the smallest possible list of prefixes that cover" +
+			" the addresses of a parent prefix not used by a set of IP addresses and prefixes.\n" +
+			" With a prefix length, return only free prefixes with this prefix length.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "parent",
+				Description: "Parent CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "used",
+				Description: "Set of used IP addresses and prefixes",
+			},
+			function.Int32Parameter{
+				Name:           "bits",
+				Description:    "(Optional) Prefix length of free prefixes",
+				AllowNullValue: true,
+				Validators: []function.Int32ParameterValidator{
+					int32validator.Between(0, 128),
+				},
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f freePrefixesFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputParent string
+		inputUsed   []string
+		inputBits   types.Int32
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputParent, &inputUsed, &inputBits))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, funcErr := parseCIDRInput(inputParent, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	used := make([]netip.Prefix, 0, len(inputUsed))
+	for _, item := range inputUsed {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		used = append(used, prefix)
+	}
+
+	free := prefixesExclude([]netip.Prefix{parent}, used)
+
+	if !inputBits.IsNull() {
+		bits := int(inputBits.ValueInt32())
+		if bits < parent.Bits() || bits > parent.Addr().BitLen() {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(2, "Invalid bits"),
+				function.NewFuncError(fmt.Sprintf("must be between %d and %d for parent %s",
+					parent.Bits(), parent.Addr().BitLen(), parent.Masked().String())),
+			)
+
+			return
+		}
+
+		// split free prefixes larger than bits and drop free prefixes smaller than bits
+		split := make([]netip.Prefix, 0, len(free))
+		for _, prefix := range free {
+			if prefix.Bits() > bits {
+				continue
+			}
+
+			subnets, ok := prefixSubnets(prefix, bits, int64(freePrefixesMaxSplit-len(split)))
+			if !ok {
+				resp.Error = function.ConcatFuncErrors(
+					function.NewArgumentFuncError(2, "Too many free prefixes"),
+					function.NewFuncError(fmt.Sprintf("splitting free space of %s into /%d prefixes"+
+						" generates more than %d prefixes",
+						parent.Masked().String(), bits, freePrefixesMaxSplit)),
+				)
+
+				return
+			}
+			split = append(split, subnets...)
+		}
+
+		free = split
+	}
+
+	result := make([]string, len(free))
+	for i, p := range free {
+		result[i] = p.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
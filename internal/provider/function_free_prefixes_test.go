@@ -0,0 +1,204 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionFreePrefixes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		parent      string
+		used        string
+		bits        string
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"empty_parent": {
+			parent:      "",
+			used:        `[]`,
+			bits:        `null`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_parent": {
+			parent:      "10.0.0.a/24",
+			used:        `[]`,
+			bits:        `null`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_used": {
+			parent:      "10.0.0.0/24",
+			used:        `["10.0.0.a"]`,
+			bits:        `null`,
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"ipv4": {
+			parent: "10.0.0.0/24",
+			used:   `["10.0.0.0/26", "10.0.0.128/27"]`,
+			bits:   `null`,
+			output: []string{
+				"10.0.0.64/26",
+				"10.0.0.160/27",
+				"10.0.0.192/26",
+			},
+		},
+		"ipv4_outside_parent": {
+			parent: "10.0.0.0/24",
+			used:   `["10.0.0.0/25", "192.0.2.0/24", "2001:db8::/32"]`,
+			bits:   `null`,
+			output: []string{
+				"10.0.0.128/25",
+			},
+		},
+		"ipv4_address": {
+			parent: "10.0.0.0/29",
+			used:   `["10.0.0.1"]`,
+			bits:   `null`,
+			output: []string{
+				"10.0.0.0/32",
+				"10.0.0.2/31",
+				"10.0.0.4/30",
+			},
+		},
+		"ipv4_empty_used": {
+			parent: "10.0.0.1/24",
+			used:   `[]`,
+			bits:   `null`,
+			output: []string{
+				"10.0.0.0/24",
+			},
+		},
+		"ipv4_exhausted": {
+			parent: "10.0.0.0/24",
+			used:   `["10.0.0.0/8"]`,
+			bits:   `null`,
+			output: []string{},
+		},
+		"ipv4_bits": {
+			parent: "10.0.0.0/24",
+			used:   `["10.0.0.0/26", "10.0.0.128/27"]`,
+			bits:   `27`,
+			output: []string{
+				"10.0.0.64/27",
+				"10.0.0.96/27",
+				"10.0.0.160/27",
+				"10.0.0.192/27",
+				"10.0.0.224/27",
+			},
+		},
+		"ipv4_bits_drop_smaller": {
+			parent: "10.0.0.0/29",
+			used:   `["10.0.0.1"]`,
+			bits:   `31`,
+			output: []string{
+				"10.0.0.2/31",
+				"10.0.0.4/31",
+				"10.0.0.6/31",
+			},
+		},
+		"ipv4_bits_too_small": {
+			parent:      "10.0.0.0/24",
+			used:        `[]`,
+			bits:        `23`,
+			expectError: regexp.MustCompile("Invalid bits"),
+		},
+		"ipv4_bits_too_big": {
+			parent:      "10.0.0.0/24",
+			used:        `[]`,
+			bits:        `33`,
+			expectError: regexp.MustCompile("Invalid bits"),
+		},
+		"ipv6": {
+			parent: "2001:db8::/56",
+			used:   `["2001:db8::/64"]`,
+			bits:   `null`,
+			output: []string{
+				"2001:db8:0:1::/64",
+				"2001:db8:0:2::/63",
+				"2001:db8:0:4::/62",
+				"2001:db8:0:8::/61",
+				"2001:db8:0:10::/60",
+				"2001:db8:0:20::/59",
+				"2001:db8:0:40::/58",
+				"2001:db8:0:80::/57",
+			},
+		},
+		"ipv6_bits": {
+			parent: "2001:db8::/62",
+			used:   `["2001:db8::/64", "2001:db8:0:2::"]`,
+			bits:   `64`,
+			output: []string{
+				"2001:db8:0:1::/64",
+				"2001:db8:0:3::/64",
+			},
+		},
+		"ipv6_too_many": {
+			parent:      "2001:db8::/48",
+			used:        `["2001:db8::/64"]`,
+			bits:        `128`,
+			expectError: regexp.MustCompile("Too many free prefixes"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::free_prefixes("` + test.parent + `", ` +
+								`toset(` + test.used + `), ` + test.bits + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::free_prefixes("` + test.parent + `", ` +
+								`toset(` + test.used + `), ` + test.bits + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
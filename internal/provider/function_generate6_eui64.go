@@ -89,20 +89,9 @@ func (f generate6EUI64Function) Run(
 		return
 	}
 
-	mac, err := net.ParseMAC(inputMac)
-	if err != nil {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(0, "Invalid MAC"),
-			function.NewFuncError("unable to parse MAC address input: "+err.Error()),
-		)
-
-		return
-	}
-	if len(mac) != 6 {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(0, "Invalid MAC"),
-			function.NewFuncError("MAC address must be in EUI-48 format"),
-		)
+	mac, funcErr := parseMACInput(inputMac, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
 
 		return
 	}
@@ -119,6 +108,26 @@ func (f generate6EUI64Function) Run(
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
 }
 
+// parseMACInput parses a MAC address in EUI-48 format
+// and returns an argument error with position if the input is invalid.
+func parseMACInput(input string, position int64) (net.HardwareAddr, *function.FuncError) {
+	mac, err := net.ParseMAC(input)
+	if err != nil {
+		return nil, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid MAC"),
+			function.NewFuncError("unable to parse MAC address input: "+err.Error()),
+		)
+	}
+	if len(mac) != 6 {
+		return nil, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid MAC"),
+			function.NewFuncError("MAC address must be in EUI-48 format"),
+		)
+	}
+
+	return mac, nil
+}
+
 func computeIPv6AddressEUI64(prefix netip.Addr, mac net.HardwareAddr) netip.Addr {
 	if !prefix.Is6() || len(mac) != 6 {
 		return netip.Addr{}
@@ -56,6 +56,11 @@ func TestAccFunctionGenerate6EUI64(t *testing.T) {
 			inputMac:    "00-00-5E-00-53-100",
 			expectError: regexp.MustCompile("Invalid MAC"),
 		},
+		"invalid_mac_argument_position": {
+			inputPrefix: "2001:db8::",
+			inputMac:    "00-00-5E-00-53-0g",
+			expectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"mac"\s+parameter`),
+		},
 		"prefix_cidr": {
 			inputPrefix: "2001:db8::1/64",
 			inputMac:    "02-00-5E-00-53-00",
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = generate6LinkLocalFunction{}
+
+func newGenerate6LinkLocalFunction() function.Function {
+	return generate6LinkLocalFunction{}
+}
+
+type generate6LinkLocalFunction struct{}
+
+func (f generate6LinkLocalFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "generate6_link_local"
+}
+
+func (f generate6LinkLocalFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate an IPv6 link-local address from MAC address with the modified EUI-64 format.",
+		Description: "Generate an IPv6 link-local address (fe80::/64) from MAC address" +
+			" with the modified EUI-64 format, as defined in RFC 4291 section 2.5.1," +
+			" with an optional scoped zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "mac",
+				Description: "MAC address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:           "zone",
+				Description:    "(Optional) Scoped zone to append to the address",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f generate6LinkLocalFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputMac  string
+		inputZone types.String
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputMac, &inputZone))
+	if resp.Error != nil {
+		return
+	}
+
+	mac, funcErr := parseMACInput(inputMac, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	output := computeIPv6AddressEUI64(linkLocalPrefix(), mac)
+	if !output.IsValid() {
+		// if happen, it's a bug
+		resp.Error = function.NewFuncError("Internal Error," +
+			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+		return
+	}
+
+	output, funcErr = addrWithZoneInput(output, inputZone, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// linkLocalPrefix returns the prefix address of IPv6 link-local addresses (fe80::/64).
+func linkLocalPrefix() netip.Addr {
+	return netip.AddrFrom16([16]byte{0xfe, 0x80})
+}
+
+// addrWithZoneInput returns addr with the scoped zone of input if not null
+// and returns an argument error with position if the zone is invalid.
+func addrWithZoneInput(addr netip.Addr, input types.String, position int64) (netip.Addr, *function.FuncError) {
+	if input.IsNull() {
+		return addr, nil
+	}
+
+	zone := input.ValueString()
+	if strings.ContainsAny(zone, "% \t\n") {
+		return netip.Addr{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid zone"),
+			function.NewFuncError("zone must not contain '%' or space characters"),
+		)
+	}
+
+	return addr.WithZone(zone), nil
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = generate6LinkLocalOpaqueFunction{}
+
+func newGenerate6LinkLocalOpaqueFunction() function.Function {
+	return generate6LinkLocalOpaqueFunction{}
+}
+
+type generate6LinkLocalOpaqueFunction struct{}
+
+func (f generate6LinkLocalOpaqueFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "generate6_link_local_opaque"
+}
+
+func (f generate6LinkLocalOpaqueFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate an IPv6 link-local address with an opaque interface identifier.",
+		Description: "Generate an IPv6 link-local address (fe80::/64) with an opaque interface identifier," +
+			" as defined in RFC 7217 section 5, with an optional scoped zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "net_iface",
+				Description: "Interface identifier",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:           "network_id",
+				Description:    "(Optional) Network subnet identifier",
+				AllowNullValue: true,
+			},
+			function.Int32Parameter{
+				Name:           "dad_counter",
+				Description:    "(Optional) Counter to resolve DAD conflict",
+				AllowNullValue: true,
+				Validators: []function.Int32ParameterValidator{
+					int32validator.AtLeast(0),
+				},
+			},
+			function.StringParameter{
+				Name:        "secret_key",
+				Description: "Secret key",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(16), // at least 128 bits in UTF8 encoding
+				},
+			},
+			function.StringParameter{
+				Name:           "zone",
+				Description:    "(Optional) Scoped zone to append to the address",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f generate6LinkLocalOpaqueFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputNetIface, inputSecretKey string
+		inputNetworkID, inputZone     types.String
+		inputDADCounter               types.Int32
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx,
+		&inputNetIface,
+		&inputNetworkID,
+		&inputDADCounter,
+		&inputSecretKey,
+		&inputZone,
+	))
+	if resp.Error != nil {
+		return
+	}
+
+	opaque, funcErr := parseOpaqueInputs(inputNetIface, inputNetworkID, inputDADCounter, inputSecretKey, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	output := computeIPv6AddressOpaque(
+		linkLocalPrefix(),
+		opaque.netIface,
+		opaque.networkID,
+		opaque.dadCounter,
+		opaque.secretKey,
+	)
+	if !output.IsValid() {
+		// if happen, it's a bug
+		resp.Error = function.NewFuncError("Internal Error," +
+			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+		return
+	}
+
+	output, funcErr = addrWithZoneInput(output, inputZone, 4)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
@@ -0,0 +1,134 @@
+package provider_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionGenerate6LinkLocalOpaque(t *testing.T) {
+	t.Parallel()
+
+	const secretKeyTest = "secret_key-secret_key"
+	networkID := "123"
+	dadCounter1 := int32(1)
+	zone := "eth0"
+	invalidZone := "eth 0"
+
+	type testCase struct {
+		inputNetIface   string
+		inputNetworkID  *string
+		inputDADCounter *int32
+		inputSecretKey  string
+		inputZone       *string
+		expectError     *regexp.Regexp
+		output          string
+	}
+
+	tests := map[string]testCase{
+		"empty_net_iface": {
+			inputNetIface:  "",
+			inputSecretKey: secretKeyTest,
+			expectError:    regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"too_small_secret_key": {
+			inputNetIface:  "00-00-5E-00-53-00",
+			inputSecretKey: string([]byte(secretKeyTest)[0:8]),
+			expectError:    regexp.MustCompile(`Invalid Parameter Value Length`),
+		},
+		"invalid_zone": {
+			inputNetIface:  "00-00-5E-00-53-00",
+			inputSecretKey: secretKeyTest,
+			inputZone:      &invalidZone,
+			expectError:    regexp.MustCompile("Invalid zone"),
+		},
+		"valid": {
+			inputNetIface:  "00-00-5E-00-53-00",
+			inputSecretKey: secretKeyTest,
+			output:         "fe80::374e:8e0a:5de9:71cc",
+		},
+		"valid+1": {
+			inputNetIface:   "00-00-5E-00-53-00",
+			inputDADCounter: &dadCounter1,
+			inputSecretKey:  secretKeyTest,
+			output:          "fe80::65e9:568f:1d6:f0a4",
+		},
+		"valid_network_id_zone": {
+			inputNetIface:  "eth0",
+			inputNetworkID: &networkID,
+			inputSecretKey: secretKeyTest,
+			inputZone:      &zone,
+			output:         "fe80::8707:476:3661:d360%eth0",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arguments := `"` + test.inputNetIface + `"`
+			if test.inputNetworkID != nil {
+				arguments += `, "` + *test.inputNetworkID + `"`
+			} else {
+				arguments += `, null`
+			}
+			if test.inputDADCounter != nil {
+				arguments += `, ` + strconv.Itoa(int(*test.inputDADCounter))
+			} else {
+				arguments += `, null`
+			}
+			arguments += `, "` + test.inputSecretKey + `"`
+			if test.inputZone != nil {
+				arguments += `, "` + *test.inputZone + `"`
+			} else {
+				arguments += `, null`
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_link_local_opaque(` + arguments + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_link_local_opaque(` + arguments + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionGenerate6LinkLocal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		mac         string
+		zone        string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty_mac": {
+			mac:         "",
+			zone:        "null",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_mac": {
+			mac:         "00:00:5e:00:53",
+			zone:        "null",
+			expectError: regexp.MustCompile("Invalid MAC"),
+		},
+		"invalid_mac_eui64": {
+			mac:         "00:00:5e:00:53:00:00:00",
+			zone:        "null",
+			expectError: regexp.MustCompile("Invalid MAC"),
+		},
+		"empty_zone": {
+			mac:         "00:00:5e:00:53:00",
+			zone:        "\"\"",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_zone": {
+			mac:         "00:00:5e:00:53:00",
+			zone:        "\"eth%0\"",
+			expectError: regexp.MustCompile("Invalid zone"),
+		},
+		"valid": {
+			mac:    "00-00-5E-00-53-00",
+			zone:   "null",
+			output: "fe80::200:5eff:fe00:5300",
+		},
+		"valid_with_zone": {
+			mac:    "00:00:5e:00:53:00",
+			zone:   "\"eth0\"",
+			output: "fe80::200:5eff:fe00:5300%eth0",
+		},
+		"valid_universal": {
+			mac:    "02:00:5e:00:53:01",
+			zone:   "\"ens18.100\"",
+			output: "fe80::5eff:fe00:5301%ens18.100",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_link_local("` + test.mac + `", ` + test.zone + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_link_local("` + test.mac + `", ` + test.zone + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/binary"
+	"math/bits"
 	"net/netip"
 	"strings"
 
@@ -14,6 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	opaquePRFSHA256     = "sha256"
+	opaquePRFHMACSHA256 = "hmac_sha256"
+	opaquePRFSipHash    = "siphash"
+)
+
 var _ function.Function = generate6OpaqueFunction{}
 
 func newGenerate6OpaqueFunction() function.Function {
@@ -85,10 +93,9 @@ func (f generate6OpaqueFunction) Run(
 	resp *function.RunResponse,
 ) {
 	var (
-		inputPrefix, inputNetIface, inputSecretKey, networkID string
-		inputNetworkID                                        types.String
-		inputDADCounter                                       types.Int32
-		dadCounter                                            int32
+		inputPrefix, inputNetIface, inputSecretKey string
+		inputNetworkID                             types.String
+		inputDADCounter                            types.Int32
 	)
 	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx,
 		&inputPrefix,
@@ -101,79 +108,108 @@ func (f generate6OpaqueFunction) Run(
 		return
 	}
 
-	if !inputNetworkID.IsNull() {
-		networkID = inputNetworkID.ValueString()
+	prefix, funcErr := parseOpaquePrefixInput(inputPrefix)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
 	}
-	if !inputDADCounter.IsNull() {
-		dadCounter = inputDADCounter.ValueInt32()
+	opaque, funcErr := parseOpaqueInputs(inputNetIface, inputNetworkID, inputDADCounter, inputSecretKey, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	output := computeIPv6AddressOpaque(
+		prefix,
+		opaque.netIface,
+		opaque.networkID,
+		opaque.dadCounter,
+		opaque.secretKey,
+	)
+	if !output.IsValid() {
+		// if happen, it's a bug
+		resp.Error = function.NewFuncError("Internal Error," +
+			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+		return
 	}
 
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// opaqueInputs is the input of an opaque interface identifier generation
+// (all except the prefix).
+type opaqueInputs struct {
+	netIface   []byte
+	networkID  []byte
+	dadCounter uint32
+	secretKey  []byte
+}
+
+// parseOpaquePrefixInput parses the prefix address (first argument) of generate6_opaque functions
+// with potential mask and scoped zone removed
+// and returns it or an argument error if the input is invalid or not an IPv6 address.
+func parseOpaquePrefixInput(input string) (netip.Addr, *function.FuncError) {
 	// remove potential mask
-	inputPrefix, _, _ = strings.Cut(inputPrefix, "/")
+	input, _, _ = strings.Cut(input, "/")
 	// remove potential scoped zone
-	inputPrefix, _, _ = strings.Cut(inputPrefix, "%")
+	input, _, _ = strings.Cut(input, "%")
 
-	prefix, err := netip.ParseAddr(inputPrefix)
+	prefix, err := netip.ParseAddr(input)
 	if err != nil {
-		resp.Error = function.ConcatFuncErrors(
+		return netip.Addr{}, function.ConcatFuncErrors(
 			function.NewArgumentFuncError(0, "Invalid Prefix"),
 			function.NewFuncError("unable to parse prefix address input: "+err.Error()),
 		)
-
-		return
 	}
 	if !prefix.Is6() {
-		resp.Error = function.ConcatFuncErrors(
+		return netip.Addr{}, function.ConcatFuncErrors(
 			function.NewArgumentFuncError(0, "Invalid Prefix"),
 			function.NewFuncError("prefix address must be an IPv6 address"),
 		)
-
-		return
 	}
 
-	if inputNetIface == "" {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(1, "Invalid Net_Iface"),
+	return prefix, nil
+}
+
+// parseOpaqueInputs validates the net_iface, network_id (null as empty), dad_counter (null as 0)
+// and secret_key inputs of generate6_opaque functions
+// and returns them or an argument error with position of net_iface
+// (network_id, dad_counter and secret_key being the next arguments) if an input is invalid.
+func parseOpaqueInputs(
+	netIface string, networkID types.String, dadCounter types.Int32, secretKey string, position int64,
+) (opaqueInputs, *function.FuncError) {
+	if netIface == "" {
+		return opaqueInputs{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid Net_Iface"),
 			function.NewFuncError("value is empty"),
 		)
-
-		return
 	}
-	if dadCounter < 0 {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(3, "Invalid DAD_Counter"),
+	if !dadCounter.IsNull() && dadCounter.ValueInt32() < 0 {
+		return opaqueInputs{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position+2, "Invalid DAD_Counter"),
 			function.NewFuncError("must be at least 0"),
 		)
-
-		return
 	}
-	if len(inputSecretKey) < 16 {
-		resp.Error = function.ConcatFuncErrors(
-			function.NewArgumentFuncError(4, "Invalid secret_key"),
+	if len(secretKey) < 16 {
+		return opaqueInputs{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position+3, "Invalid secret_key"),
 			function.NewFuncError("value is too small, must be at least 128 bits in UTF8 encoding"),
 		)
-
-		return
-	}
-
-	output := computeIPv6AddressOpaque(
-		prefix,
-		[]byte(inputNetIface),
-		[]byte(networkID),
-		uint32(dadCounter),
-		[]byte(inputSecretKey),
-	)
-	if !output.IsValid() {
-		// if happen, it's a bug
-		resp.Error = function.NewFuncError("Internal Error," +
-			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
-
-		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+	return opaqueInputs{
+		netIface:   []byte(netIface),
+		networkID:  []byte(networkID.ValueString()),
+		dadCounter: uint32(dadCounter.ValueInt32()),
+		secretKey:  []byte(secretKey),
+	}, nil
 }
 
+// computeIPv6AddressOpaque returns the IPv6 address with an opaque interface identifier
+// generated with SHA256 as the pseudorandom function.
 func computeIPv6AddressOpaque(
 	prefix netip.Addr,
 	netIface []byte,
@@ -181,29 +217,62 @@ func computeIPv6AddressOpaque(
 	dadCounter uint32,
 	secretKey []byte,
 ) netip.Addr {
+	output, _ := computeIPv6AddressOpaqueDetail(prefix, netIface, networkID, dadCounter, secretKey, opaquePRFSHA256)
+
+	return output
+}
+
+// computeIPv6AddressOpaqueDetail returns the IPv6 address with an opaque interface identifier
+// generated with the pseudorandom function prf
+// and the DAD counter used to generate it
+// (incremented when the interface identifier collides with a reserved one).
+func computeIPv6AddressOpaqueDetail(
+	prefix netip.Addr,
+	netIface []byte,
+	networkID []byte,
+	dadCounter uint32,
+	secretKey []byte,
+	prf string,
+) (netip.Addr, uint32) {
 	if !prefix.Is6() || len(netIface) == 0 || len(secretKey) < 16 {
-		return netip.Addr{}
+		return netip.Addr{}, 0
 	}
 
 	newAddress := prefix.AsSlice()
 
-	hash := sha256.New()
-	_, _ = hash.Write(newAddress[0:8]) // It never returns an error.
-	_, _ = hash.Write(netIface)        // It never returns an error.
-	_, _ = hash.Write(networkID)       // It never returns an error.
-	if err := binary.Write(hash, binary.LittleEndian, dadCounter); err != nil {
-		return netip.Addr{}
+	data := bytes.NewBuffer(nil)
+	_, _ = data.Write(newAddress[0:8]) // It never returns an error.
+	_, _ = data.Write(netIface)        // It never returns an error.
+	_, _ = data.Write(networkID)       // It never returns an error.
+	if err := binary.Write(data, binary.LittleEndian, dadCounter); err != nil {
+		return netip.Addr{}, 0
 	}
-	_, _ = hash.Write(secretKey) // It never returns an error.
 
 	// compute a random identifier and limit to 64bit
-	iid := hash.Sum(nil)[0:8]
+	var iid []byte
+	switch prf {
+	case opaquePRFSHA256:
+		_, _ = data.Write(secretKey) // It never returns an error.
+		sum := sha256.Sum256(data.Bytes())
+		iid = sum[0:8]
+	case opaquePRFHMACSHA256:
+		hash := hmac.New(sha256.New, secretKey)
+		_, _ = hash.Write(data.Bytes()) // It never returns an error.
+		iid = hash.Sum(nil)[0:8]
+	case opaquePRFSipHash:
+		if len(secretKey) != 16 {
+			return netip.Addr{}, 0
+		}
+		iid = binary.LittleEndian.AppendUint64(nil, sipHash24([16]byte(secretKey), data.Bytes()))
+	default:
+		return netip.Addr{}, 0
+	}
 	newAddr, _ := netip.AddrFromSlice(append(newAddress[0:8], iid...))
 
 	// check colision with reserved IPv6 interface identifiers
 	// cf https://www.iana.org/assignments/ipv6-interface-ids/ipv6-interface-ids.xhtml
 	if bytes.Equal(iid,
-		[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, // 0000:0000:0000:0000
+		[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, // 0000:0000:0000:0000
 	) {
 		goto colision
 	}
@@ -234,8 +303,60 @@ func computeIPv6AddressOpaque(
 		goto colision
 	}
 
-	return newAddr
+	return newAddr, dadCounter
 
 colision: // retry with DAD_counter+1
-	return computeIPv6AddressOpaque(prefix, netIface, networkID, dadCounter+1, secretKey)
+	return computeIPv6AddressOpaqueDetail(prefix, netIface, networkID, dadCounter+1, secretKey, prf)
+}
+
+// sipHash24 returns the SipHash-2-4 of data with key.
+func sipHash24(key [16]byte, data []byte) uint64 {
+	k0 := binary.LittleEndian.Uint64(key[0:8])
+	k1 := binary.LittleEndian.Uint64(key[8:16])
+
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	round := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+	compress := func(m uint64) {
+		v3 ^= m
+		round()
+		round()
+		v0 ^= m
+	}
+
+	length := len(data)
+	for ; len(data) >= 8; data = data[8:] {
+		compress(binary.LittleEndian.Uint64(data))
+	}
+	// last block with remaining bytes and length of data in the most significant byte
+	var last [8]byte
+	copy(last[:], data)
+	last[7] = byte(length)
+	compress(binary.LittleEndian.Uint64(last[:]))
+
+	v2 ^= 0xff
+	round()
+	round()
+	round()
+	round()
+
+	return v0 ^ v1 ^ v2 ^ v3
 }
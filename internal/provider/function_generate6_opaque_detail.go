@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = generate6OpaqueDetailFunction{}
+
+func newGenerate6OpaqueDetailFunction() function.Function {
+	return generate6OpaqueDetailFunction{}
+}
+
+type generate6OpaqueDetailFunction struct{}
+
+type generate6OpaqueDetailFunctionResult struct {
+	Address    string `tfsdk:"address"`
+	DADCounter int32  `tfsdk:"dad_counter"`
+}
+
+func (f generate6OpaqueDetailFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "generate6_opaque_detail"
+}
+
+func (f generate6OpaqueDetailFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate an IPv6 address with an opaque interface identifier and return the DAD counter used.",
+		Description: "Generate an IPv6 address with an opaque interface identifier," +
+			" as defined in RFC 7217 section 5, with a choice of pseudorandom function," +
+			" and return the address with the DAD counter used to generate it" +
+			" (incremented when the interface identifier collides with a reserved one).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "IPv6 prefix address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "net_iface",
+				Description: "Interface identifier",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:           "network_id",
+				Description:    "(Optional) Network subnet identifier",
+				AllowNullValue: true,
+			},
+			function.Int32Parameter{
+				Name:           "dad_counter",
+				Description:    "(Optional) Counter to resolve DAD conflict",
+				AllowNullValue: true,
+				Validators: []function.Int32ParameterValidator{
+					int32validator.AtLeast(0),
+				},
+			},
+			function.StringParameter{
+				Name:        "secret_key",
+				Description: "Secret key",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(16), // at least 128 bits in UTF8 encoding
+				},
+			},
+			function.StringParameter{
+				Name:           "prf",
+				Description:    "(Optional) Pseudorandom function: `sha256`, `hmac_sha256` or `siphash`",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						opaquePRFSHA256,
+						opaquePRFHMACSHA256,
+						opaquePRFSipHash,
+					),
+				},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"address":     types.StringType,
+				"dad_counter": types.Int32Type,
+			},
+		},
+	}
+}
+
+func (f generate6OpaqueDetailFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputPrefix, inputNetIface, inputSecretKey string
+		inputNetworkID, inputPRF                   types.String
+		inputDADCounter                            types.Int32
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx,
+		&inputPrefix,
+		&inputNetIface,
+		&inputNetworkID,
+		&inputDADCounter,
+		&inputSecretKey,
+		&inputPRF,
+	))
+	if resp.Error != nil {
+		return
+	}
+
+	prf := opaquePRFSHA256
+	if !inputPRF.IsNull() {
+		prf = inputPRF.ValueString()
+	}
+
+	prefix, funcErr := parseOpaquePrefixInput(inputPrefix)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	opaque, funcErr := parseOpaqueInputs(inputNetIface, inputNetworkID, inputDADCounter, inputSecretKey, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	if prf == opaquePRFSipHash && len(opaque.secretKey) != 16 {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(4, "Invalid secret_key"),
+			function.NewFuncError("value must be exactly 128 bits in UTF8 encoding with "+
+				opaquePRFSipHash+" pseudorandom function"),
+		)
+
+		return
+	}
+
+	output, outputDADCounter := computeIPv6AddressOpaqueDetail(
+		prefix,
+		opaque.netIface,
+		opaque.networkID,
+		opaque.dadCounter,
+		opaque.secretKey,
+		prf,
+	)
+	if !output.IsValid() {
+		// if happen, it's a bug
+		resp.Error = function.NewFuncError("Internal Error," +
+			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, generate6OpaqueDetailFunctionResult{
+		Address:    output.String(),
+		DADCounter: int32(outputDADCounter),
+	}))
+}
@@ -0,0 +1,159 @@
+package provider_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionGenerate6OpaqueDetail(t *testing.T) {
+	t.Parallel()
+
+	const secretKeyTest = "secret_key-secret_key"
+	networkID := "123"
+	dadCounter1 := int32(1)
+	prfHMACSHA256 := "hmac_sha256"
+	prfSipHash := "siphash"
+	invalidPRF := "md5"
+
+	type testCase struct {
+		inputPrefix     string
+		inputNetIface   string
+		inputNetworkID  *string
+		inputDADCounter *int32
+		inputSecretKey  string
+		inputPRF        *string
+		expectError     *regexp.Regexp
+		outputAddress   string
+		outputDAD       int32
+	}
+
+	tests := map[string]testCase{
+		"ipv4_prefix": {
+			inputPrefix:    "192.0.2.0",
+			inputNetIface:  "eth0",
+			inputSecretKey: secretKeyTest,
+			expectError:    regexp.MustCompile("prefix address must be an IPv6 address"),
+		},
+		"invalid_prf": {
+			inputPrefix:    "fe80::",
+			inputNetIface:  "eth0",
+			inputSecretKey: secretKeyTest,
+			inputPRF:       &invalidPRF,
+			expectError:    regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"siphash_too_long_secret_key": {
+			inputPrefix:    "fe80::",
+			inputNetIface:  "eth0",
+			inputSecretKey: secretKeyTest,
+			inputPRF:       &prfSipHash,
+			expectError:    regexp.MustCompile("must be exactly 128 bits"),
+		},
+		"valid": {
+			inputPrefix:    "fe80::",
+			inputNetIface:  "00-00-5E-00-53-00",
+			inputSecretKey: secretKeyTest,
+			outputAddress:  "fe80::374e:8e0a:5de9:71cc",
+			outputDAD:      0,
+		},
+		"valid+1": {
+			inputPrefix:     "fe80::/64",
+			inputNetIface:   "00-00-5E-00-53-00",
+			inputDADCounter: &dadCounter1,
+			inputSecretKey:  secretKeyTest,
+			outputAddress:   "fe80::65e9:568f:1d6:f0a4",
+			outputDAD:       1,
+		},
+		"valid_hmac_sha256": {
+			inputPrefix:    "2001:db8::/64",
+			inputNetIface:  "eth0",
+			inputNetworkID: &networkID,
+			inputSecretKey: secretKeyTest,
+			inputPRF:       &prfHMACSHA256,
+			outputAddress:  "2001:db8::8237:69f3:4504:e5ae",
+			outputDAD:      0,
+		},
+		"valid_siphash": {
+			inputPrefix:    "2001:db8::/64",
+			inputNetIface:  "eth0",
+			inputSecretKey: "0123456789abcdef",
+			inputPRF:       &prfSipHash,
+			outputAddress:  "2001:db8::a40a:57ef:efc3:15ae",
+			outputDAD:      0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arguments := `"` + test.inputPrefix + `"`
+			arguments += `, "` + test.inputNetIface + `"`
+			if test.inputNetworkID != nil {
+				arguments += `, "` + *test.inputNetworkID + `"`
+			} else {
+				arguments += `, null`
+			}
+			if test.inputDADCounter != nil {
+				arguments += `, ` + strconv.Itoa(int(*test.inputDADCounter))
+			} else {
+				arguments += `, null`
+			}
+			arguments += `, "` + test.inputSecretKey + `"`
+			if test.inputPRF != nil {
+				arguments += `, "` + *test.inputPRF + `"`
+			} else {
+				arguments += `, null`
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_opaque_detail(` + arguments + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::generate6_opaque_detail(` + arguments + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"address":     knownvalue.StringExact(test.outputAddress),
+										"dad_counter": knownvalue.Int32Exact(test.outputDAD),
+									}),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSipHash24(t *testing.T) {
+	t.Parallel()
+
+	// test vectors of the SipHash reference implementation
+	// with key 00 01 02 ... 0f and message 00 01 02 ... (length-1)
+	key := [16]byte{0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf}
+
+	type testCase struct {
+		length int
+		output uint64
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			length: 0,
+			output: 0x726fdb47dd0e0e31,
+		},
+		"one_byte": {
+			length: 1,
+			output: 0x74f839c593dc67fd,
+		},
+		"one_block": {
+			length: 8,
+			output: 0x93f5f5799a932462,
+		},
+		"one_block_and_partial": {
+			length: 15,
+			output: 0xa129ca6149be45e5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := make([]byte, test.length)
+			for i := range data {
+				data[i] = byte(i)
+			}
+
+			if output := sipHash24(key, data); output != test.output {
+				t.Errorf("got unexpected result: want %x, got %x", test.output, output)
+			}
+		})
+	}
+}
+
+func TestParseOpaqueInputs(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		netIface         string
+		networkID        types.String
+		dadCounter       types.Int32
+		secretKey        string
+		expectErrPos     int64
+		expectErr        bool
+		expectNetworkID  string
+		expectDADCounter uint32
+	}
+
+	tests := map[string]testCase{
+		"null": {
+			netIface:   "eth0",
+			networkID:  types.StringNull(),
+			dadCounter: types.Int32Null(),
+			secretKey:  "0123456789abcdef",
+		},
+		"values": {
+			netIface:         "eth0",
+			networkID:        types.StringValue("net"),
+			dadCounter:       types.Int32Value(2),
+			secretKey:        "0123456789abcdef",
+			expectNetworkID:  "net",
+			expectDADCounter: 2,
+		},
+		"empty_net_iface": {
+			networkID:    types.StringNull(),
+			dadCounter:   types.Int32Null(),
+			secretKey:    "0123456789abcdef",
+			expectErr:    true,
+			expectErrPos: 1,
+		},
+		"negative_dad_counter": {
+			netIface:     "eth0",
+			networkID:    types.StringNull(),
+			dadCounter:   types.Int32Value(-1),
+			secretKey:    "0123456789abcdef",
+			expectErr:    true,
+			expectErrPos: 3,
+		},
+		"small_secret_key": {
+			netIface:     "eth0",
+			networkID:    types.StringNull(),
+			dadCounter:   types.Int32Null(),
+			secretKey:    "0123456789",
+			expectErr:    true,
+			expectErrPos: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opaque, funcErr := parseOpaqueInputs(test.netIface, test.networkID, test.dadCounter, test.secretKey, 1)
+			if test.expectErr {
+				if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != test.expectErrPos {
+					t.Errorf("got unexpected error: want error on argument %d, got %v", test.expectErrPos, funcErr)
+				}
+
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("got unexpected error: %s", funcErr.Error())
+			}
+			if string(opaque.netIface) != test.netIface || string(opaque.secretKey) != test.secretKey ||
+				string(opaque.networkID) != test.expectNetworkID || opaque.dadCounter != test.expectDADCounter {
+				t.Errorf("got unexpected inputs: %+v", opaque)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = hostFunction{}
+
+func newHostFunction() function.Function {
+	return hostFunction{}
+}
+
+type hostFunction struct{}
+
+func (f hostFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "host"
+}
+
+func (f hostFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate an IP address of a prefix with a host number.",
+		Description: "Calculate an IP address of a prefix with a host number.\n" +
+			" Host number is a string to allow numbers above 64 bits" +
+			" and can be negative to count from the end of the prefix.\n" +
+			" For IPv4 prefixes with a prefix length lower than 31," +
+			" the network and broadcast addresses can be excluded.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:        "index",
+				Description: "Host number in decimal or hexadecimal (0x prefix) format",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.BoolParameter{
+				Name:           "exclude_network",
+				Description:    "(Optional) Exclude the network address of an IPv4 prefix",
+				AllowNullValue: true,
+			},
+			function.BoolParameter{
+				Name:           "exclude_broadcast",
+				Description:    "(Optional) Exclude the broadcast address of an IPv4 prefix",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f hostFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputPrefix, inputIndex                string
+		inputExcludeNetwork, inputExcludeBcast types.Bool
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx,
+		&inputPrefix, &inputIndex, &inputExcludeNetwork, &inputExcludeBcast))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(inputPrefix, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	index, ok := parseBigInt(inputIndex)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Invalid index"),
+			function.NewFuncError("unable to parse index input: "+
+				"must be an integer in decimal or hexadecimal (0x prefix) format"),
+		)
+
+		return
+	}
+
+	first, last := prefixHostRange(prefix, inputExcludeNetwork.ValueBool(), inputExcludeBcast.ValueBool())
+
+	output, ok := addrRangeIndex(first, last, index)
+	if !ok {
+		count := new(big.Int).Sub(addrToBigInt(last), addrToBigInt(first))
+		maxIndex := new(big.Int).Set(count)
+		count.Add(count, big.NewInt(1))
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Index out of range"),
+			function.NewFuncError(fmt.Sprintf("must be between -%s and %s for hosts of %s",
+				count.String(), maxIndex.String(), prefix.Masked().String())),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// prefixHostRange returns the first and last host addresses of prefix.
+// The network and broadcast addresses are excluded with excludeNetwork and excludeBroadcast
+// only for IPv4 prefixes with a prefix length lower than 31.
+func prefixHostRange(prefix netip.Prefix, excludeNetwork, excludeBroadcast bool) (netip.Addr, netip.Addr) {
+	first := prefix.Masked().Addr()
+	last := prefixLastAddr(prefix)
+
+	if prefix.Addr().Is4() && prefix.Bits() < 31 {
+		if excludeNetwork {
+			first = first.Next()
+		}
+		if excludeBroadcast {
+			last = last.Prev()
+		}
+	}
+
+	return first, last
+}
+
+// addrRangeIndex returns the address at index in the range [first, last]
+// (a negative index counts from last).
+// It returns false if index is outside of the range.
+func addrRangeIndex(first, last netip.Addr, index *big.Int) (netip.Addr, bool) {
+	start := addrToBigInt(first)
+	end := addrToBigInt(last)
+
+	value := new(big.Int)
+	if index.Sign() < 0 {
+		value.Add(end, index)
+		value.Add(value, big.NewInt(1))
+	} else {
+		value.Add(start, index)
+	}
+	if value.Cmp(start) < 0 || value.Cmp(end) > 0 {
+		return netip.Addr{}, false
+	}
+
+	return addrFromBigInt(value, first.Is4())
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+)
+
+func TestAddrRangeIndex(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		first  netip.Addr
+		last   netip.Addr
+		index  int64
+		output netip.Addr
+		ok     bool
+	}
+
+	tests := map[string]testCase{
+		"ipv4_first": {
+			first:  netip.MustParseAddr("192.0.2.0"),
+			last:   netip.MustParseAddr("192.0.2.255"),
+			index:  0,
+			output: netip.MustParseAddr("192.0.2.0"),
+			ok:     true,
+		},
+		"ipv4_last": {
+			first:  netip.MustParseAddr("192.0.2.0"),
+			last:   netip.MustParseAddr("192.0.2.255"),
+			index:  255,
+			output: netip.MustParseAddr("192.0.2.255"),
+			ok:     true,
+		},
+		"ipv4_negative": {
+			first:  netip.MustParseAddr("192.0.2.1"),
+			last:   netip.MustParseAddr("192.0.2.254"),
+			index:  -1,
+			output: netip.MustParseAddr("192.0.2.254"),
+			ok:     true,
+		},
+		"ipv4_negative_first": {
+			first:  netip.MustParseAddr("192.0.2.1"),
+			last:   netip.MustParseAddr("192.0.2.254"),
+			index:  -254,
+			output: netip.MustParseAddr("192.0.2.1"),
+			ok:     true,
+		},
+		"ipv4_too_big": {
+			first: netip.MustParseAddr("192.0.2.0"),
+			last:  netip.MustParseAddr("192.0.2.255"),
+			index: 256,
+		},
+		"ipv4_too_small": {
+			first: netip.MustParseAddr("192.0.2.0"),
+			last:  netip.MustParseAddr("192.0.2.255"),
+			index: -257,
+		},
+		"ipv4_end_of_space": {
+			first:  netip.MustParseAddr("255.255.255.0"),
+			last:   netip.MustParseAddr("255.255.255.255"),
+			index:  -1,
+			output: netip.MustParseAddr("255.255.255.255"),
+			ok:     true,
+		},
+		"ipv6": {
+			first:  netip.MustParseAddr("2001:db8::"),
+			last:   netip.MustParseAddr("2001:db8::ffff:ffff:ffff:ffff"),
+			index:  0x10000,
+			output: netip.MustParseAddr("2001:db8::1:0"),
+			ok:     true,
+		},
+		"ipv6_negative": {
+			first:  netip.MustParseAddr("2001:db8::"),
+			last:   netip.MustParseAddr("2001:db8::ffff:ffff:ffff:ffff"),
+			index:  -2,
+			output: netip.MustParseAddr("2001:db8::ffff:ffff:ffff:fffe"),
+			ok:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := addrRangeIndex(test.first, test.last, big.NewInt(test.index))
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected address: want %s, got %s", test.output, result)
+			}
+		})
+	}
+}
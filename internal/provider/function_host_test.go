@@ -0,0 +1,216 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionHost(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix           string
+		index            string
+		excludeNetwork   string
+		excludeBroadcast string
+		expectError      *regexp.Regexp
+		output           string
+	}
+
+	tests := map[string]testCase{
+		"empty_prefix": {
+			prefix:           "",
+			index:            "0",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"empty_index": {
+			prefix:           "10.0.0.0/24",
+			index:            "",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_prefix": {
+			prefix:           "10.0.0.a/24",
+			index:            "0",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_index": {
+			prefix:           "10.0.0.0/24",
+			index:            "1a",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Invalid index"),
+		},
+		"ipv4_first": {
+			prefix:           "10.0.0.0/24",
+			index:            "0",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "10.0.0.0",
+		},
+		"ipv4": {
+			prefix:           "10.0.0.0/24",
+			index:            "5",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "10.0.0.5",
+		},
+		"ipv4_lenient": {
+			prefix:           "10.1/255.255.0.0",
+			index:            "0x101",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "10.1.1.1",
+		},
+		"ipv4_last": {
+			prefix:           "10.0.0.0/24",
+			index:            "-1",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "10.0.0.255",
+		},
+		"ipv4_exclude_network": {
+			prefix:           "10.0.0.0/24",
+			index:            "0",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `null`,
+			output:           "10.0.0.1",
+		},
+		"ipv4_exclude_broadcast": {
+			prefix:           "10.0.0.0/24",
+			index:            "-1",
+			excludeNetwork:   `false`,
+			excludeBroadcast: `true`,
+			output:           "10.0.0.254",
+		},
+		"ipv4_exclude_both_last": {
+			prefix:           "10.0.0.0/24",
+			index:            "253",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `true`,
+			output:           "10.0.0.254",
+		},
+		"ipv4_exclude_point_to_point": {
+			prefix:           "10.0.0.0/31",
+			index:            "-1",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `true`,
+			output:           "10.0.0.1",
+		},
+		"ipv4_exclude_host": {
+			prefix:           "10.0.0.1/32",
+			index:            "0",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `true`,
+			output:           "10.0.0.1",
+		},
+		"ipv4_out_of_range": {
+			prefix:           "10.0.0.0/24",
+			index:            "256",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Index out of range"),
+		},
+		"ipv4_out_of_range_negative": {
+			prefix:           "10.0.0.0/24",
+			index:            "-257",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Index out of range"),
+		},
+		"ipv4_out_of_range_exclude": {
+			prefix:           "10.0.0.0/24",
+			index:            "254",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `true`,
+			expectError:      regexp.MustCompile("Index out of range"),
+		},
+		"ipv6": {
+			prefix:           "2001:db8::/64",
+			index:            "0x10000",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "2001:db8::1:0",
+		},
+		"ipv6_last": {
+			prefix:           "2001:db8::/64",
+			index:            "-1",
+			excludeNetwork:   `true`,
+			excludeBroadcast: `true`,
+			output:           "2001:db8::ffff:ffff:ffff:ffff",
+		},
+		"ipv6_full": {
+			prefix:           "::/0",
+			index:            "340282366920938463463374607431768211455",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			output:           "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+		},
+		"ipv6_out_of_range": {
+			prefix:           "::/0",
+			index:            "340282366920938463463374607431768211456",
+			excludeNetwork:   `null`,
+			excludeBroadcast: `null`,
+			expectError:      regexp.MustCompile("Index out of range"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::host("` + test.prefix + `", "` +
+								test.index + `", ` + test.excludeNetwork + `, ` + test.excludeBroadcast + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::host("` + test.prefix + `", "` +
+								test.index + `", ` + test.excludeNetwork + `, ` + test.excludeBroadcast + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
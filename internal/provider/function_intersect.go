@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = intersectFunction{}
+
+func newIntersectFunction() function.Function {
+	return intersectFunction{}
+}
+
+type intersectFunction struct{}
+
+func (f intersectFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "intersect"
+}
+
+func (f intersectFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Intersect two sets of IP prefixes.",
+		Description: "Return the smallest possible list of prefixes that cover" +
+			" the addresses present in both sets of IP addresses and prefixes.",
+		Parameters: []function.Parameter{
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "set_a",
+				Description: "First set of IP addresses and prefixes",
+			},
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "set_b",
+				Description: "Second set of IP addresses and prefixes",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f intersectFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputsA, inputsB []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputsA, &inputsB))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixesA := make([]netip.Prefix, 0, len(inputsA))
+	for _, item := range inputsA {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixesA = append(prefixesA, prefix)
+	}
+
+	prefixesB := make([]netip.Prefix, 0, len(inputsB))
+	for _, item := range inputsB {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixesB = append(prefixesB, prefix)
+	}
+
+	intersection := prefixesIntersect(prefixesA, prefixesB)
+
+	result := make([]string, len(intersection))
+	for i, p := range intersection {
+		result[i] = p.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// prefixesIntersect returns the minimal list of prefixes that covers
+// the IP space present in both prefixesA and prefixesB.
+func prefixesIntersect(prefixesA, prefixesB []netip.Prefix) []netip.Prefix {
+	// summarize to have sorted and non-overlapping prefixes
+	prefixesA = prefixesSummarize(prefixesA)
+	prefixesB = prefixesSummarize(prefixesB)
+
+	intersection := make([]netip.Prefix, 0)
+	for _, prefixA := range prefixesA {
+		for _, prefixB := range prefixesB {
+			if !prefixA.Overlaps(prefixB) {
+				// also happen when prefixes have different IP version
+				continue
+			}
+
+			// two overlapping prefixes: one contains the other,
+			// so the intersection is the longest prefix
+			if prefixA.Bits() >= prefixB.Bits() {
+				intersection = append(intersection, prefixA)
+			} else {
+				intersection = append(intersection, prefixB)
+			}
+		}
+	}
+
+	return prefixesSummarize(intersection)
+}
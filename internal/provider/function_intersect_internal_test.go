@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixesIntersect(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixesA []netip.Prefix
+		prefixesB []netip.Prefix
+		output    []netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixesA: []netip.Prefix{},
+			prefixesB: []netip.Prefix{},
+			output:    []netip.Prefix{},
+		},
+		"same": {
+			prefixesA: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+			prefixesB: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+		},
+		"ipv4_partial": {
+			prefixesA: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/23"),
+				netip.MustParsePrefix("10.0.4.0/24"),
+			},
+			prefixesB: []netip.Prefix{
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("10.0.2.0/24"),
+				netip.MustParsePrefix("10.0.4.128/25"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("10.0.4.128/25"),
+			},
+		},
+		"ipv4_merged": {
+			prefixesA: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/25"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+			},
+			prefixesB: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/16"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+			},
+		},
+		"ipv6": {
+			prefixesA: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/32"),
+			},
+			prefixesB: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8:0:1::1/128"),
+				netip.MustParsePrefix("fd00::/8"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8:0:1::1/128"),
+			},
+		},
+		"different_families": {
+			prefixesA: []netip.Prefix{
+				netip.MustParsePrefix("0.0.0.0/0"),
+			},
+			prefixesB: []netip.Prefix{
+				netip.MustParsePrefix("::/0"),
+			},
+			output: []netip.Prefix{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixesIntersect(test.prefixesA, test.prefixesB)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
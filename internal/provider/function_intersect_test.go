@@ -0,0 +1,209 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionIntersect(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		setA        []string
+		setB        []string
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"ipv4_contained": {
+			setA: []string{
+				"10.0.0.0/16",
+			},
+			setB: []string{
+				"10.0.1.0/24",
+				"10.1.0.0/24",
+			},
+			output: []string{
+				"10.0.1.0/24",
+			},
+		},
+		"ipv4_container": {
+			setA: []string{
+				"10.0.1.0/24",
+				"192.0.2.0/24",
+			},
+			setB: []string{
+				"10.0.0.0/8",
+			},
+			output: []string{
+				"10.0.1.0/24",
+			},
+		},
+		"ipv4_addresses": {
+			setA: []string{
+				"10.0.0.1",
+				"10.0.0.2",
+				"10.0.0.5",
+			},
+			setB: []string{
+				"10.0.0.0/30",
+			},
+			output: []string{
+				"10.0.0.1/32",
+				"10.0.0.2/32",
+			},
+		},
+		"ipv4_summarized": {
+			setA: []string{
+				"10.0.0.0/24",
+			},
+			setB: []string{
+				"10.0.0.0/25",
+				"10.0.0.128/25",
+			},
+			output: []string{
+				"10.0.0.0/24",
+			},
+		},
+		"ipv4_no_intersection": {
+			setA: []string{
+				"10.0.0.0/24",
+			},
+			setB: []string{
+				"10.0.1.0/24",
+			},
+			output: []string{},
+		},
+		"ipv6": {
+			setA: []string{
+				"2001:db8::/32",
+			},
+			setB: []string{
+				"2001:db8:1::/48",
+				"2001:db9::/48",
+			},
+			output: []string{
+				"2001:db8:1::/48",
+			},
+		},
+		"mixed_families": {
+			setA: []string{
+				"10.0.0.0/16",
+				"2001:db8::/32",
+			},
+			setB: []string{
+				"0.0.0.0/0",
+				"2001:db8:1::/48",
+			},
+			output: []string{
+				"10.0.0.0/16",
+				"2001:db8:1::/48",
+			},
+		},
+		"different_families": {
+			setA: []string{
+				"10.0.0.0/8",
+			},
+			setB: []string{
+				"::/0",
+			},
+			output: []string{},
+		},
+		"empty_set": {
+			setA: []string{},
+			setB: []string{
+				"10.0.0.0/8",
+			},
+			output: []string{},
+		},
+		"invalid_set_a": {
+			setA: []string{
+				"10.0.0.a/8",
+			},
+			setB:        []string{},
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_set_b": {
+			setA: []string{},
+			setB: []string{
+				"10.0.0.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedSetA := make([]string, len(test.setA))
+			for i, v := range test.setA {
+				quotedSetA[i] = fmt.Sprintf("%q", v)
+			}
+			quotedSetB := make([]string, len(test.setB))
+			for i, v := range test.setB {
+				quotedSetB[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::intersect(
+									toset([` + strings.Join(quotedSetA, ", ") + `]),
+									toset([` + strings.Join(quotedSetB, ", ") + `]),
+								)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::intersect(
+									toset([` + strings.Join(quotedSetA, ", ") + `]),
+									toset([` + strings.Join(quotedSetB, ", ") + `]),
+								)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = isEUI64Function{}
+
+func newIsEUI64Function() function.Function {
+	return isEUI64Function{}
+}
+
+type isEUI64Function struct{}
+
+func (f isEUI64Function) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "is_eui64"
+}
+
+func (f isEUI64Function) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Reports whether an IPv6 address has an interface identifier derived from a MAC address.",
+		Description: "Reports whether the interface identifier of an IPv6 address" +
+			" looks derived from a MAC address with the modified EUI-64 format (ff:fe in the middle).\n" +
+			" Reports false for IPv4 address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "Address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f isEUI64Function) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	address, funcErr := parseAddressInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	_, ok := extractMACFromIPv6AddressEUI64(address)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ok))
+}
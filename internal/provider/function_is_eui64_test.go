@@ -0,0 +1,95 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionIsEUI64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      bool
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("value must be at least 1 characters"),
+		},
+		"link_local": {
+			input:  "fe80::200:5eff:fe00:5300",
+			output: true,
+		},
+		"global_with_mask": {
+			input:  "2001:db8::2aa:ff:fe28:9c5a/64",
+			output: true,
+		},
+		"global_opaque": {
+			input:  "2001:db8::374e:8e0a:5de9:71cc",
+			output: false,
+		},
+		"ipv4": {
+			input:  "192.0.2.1",
+			output: false,
+		},
+		"invalid": {
+			input:       "fe80::zz",
+			expectError: regexp.MustCompile("unable to parse address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::is_eui64("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::is_eui64("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.Bool(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
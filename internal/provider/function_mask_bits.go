@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = maskBitsFunction{}
+
+func newMaskBitsFunction() function.Function {
+	return maskBitsFunction{}
+}
+
+type maskBitsFunction struct{}
+
+func (f maskBitsFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "mask_bits"
+}
+
+func (f maskBitsFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Return the prefix length of a netmask or a wildcard mask.",
+		Description: "Return the prefix length of a netmask or a wildcard mask (inverted netmask)" +
+			" in address format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "Netmask or wildcard mask to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.Int32Return{},
+	}
+}
+
+func (f maskBitsFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	mask, err := netip.ParseAddr(strings.TrimSpace(input))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid mask"),
+			function.NewFuncError("unable to parse mask input: "+err.Error()),
+		)
+
+		return
+	}
+
+	output, ok := ipAddrMaskOrWildcardToBits(mask)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid mask"),
+			function.NewFuncError("mask "+mask.String()+" is not a contiguous netmask or wildcard mask"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int32(output)))
+}
+
+// ipAddrMaskOrWildcardToBits returns the prefix length of an IPv4 or IPv6 netmask or wildcard mask.
+// A mask valid as netmask and as wildcard mask (all bits set or unset) is read as a netmask.
+// It returns false if mask is not a contiguous netmask or wildcard mask.
+func ipAddrMaskOrWildcardToBits(mask netip.Addr) (int, bool) {
+	toBits := ip6AddrToMaskBits
+	if mask.Is4() {
+		toBits = ipAddrToMaskBits
+	}
+
+	if maskBits, ok := toBits(mask); ok {
+		return maskBits, true
+	}
+
+	return toBits(ipAddrInvert(mask))
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIpAddrMaskOrWildcardToBits(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		mask       netip.Addr
+		expectOk   bool
+		expectBits int
+	}
+
+	tests := map[string]testCase{
+		"netmask": {
+			mask:       netip.MustParseAddr("255.255.255.0"),
+			expectOk:   true,
+			expectBits: 24,
+		},
+		"wildcard": {
+			mask:       netip.MustParseAddr("0.0.0.255"),
+			expectOk:   true,
+			expectBits: 24,
+		},
+		"all_zeros_as_netmask": {
+			mask:       netip.MustParseAddr("0.0.0.0"),
+			expectOk:   true,
+			expectBits: 0,
+		},
+		"all_ones_as_netmask": {
+			mask:       netip.MustParseAddr("255.255.255.255"),
+			expectOk:   true,
+			expectBits: 32,
+		},
+		"ipv6_all_zeros_as_netmask": {
+			mask:       netip.MustParseAddr("::"),
+			expectOk:   true,
+			expectBits: 0,
+		},
+		"ipv6_wildcard": {
+			mask:       netip.MustParseAddr("::ffff:ffff:ffff:ffff"),
+			expectOk:   true,
+			expectBits: 64,
+		},
+		"non_contiguous": {
+			mask:       netip.MustParseAddr("255.0.255.0"),
+			expectOk:   false,
+			expectBits: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bits, ok := ipAddrMaskOrWildcardToBits(test.mask)
+			if ok != test.expectOk {
+				t.Errorf("got unexpected ok: want %t, got %t", test.expectOk, ok)
+			}
+			if bits != test.expectBits {
+				t.Errorf("got unexpected bits: want %d, got %d", test.expectBits, bits)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionMaskBits(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      int32
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"netmask": {
+			input:  "255.255.255.0",
+			output: 24,
+		},
+		"netmask_space": {
+			input:  " 255.255.240.0 ",
+			output: 20,
+		},
+		"netmask_0": {
+			input:  "0.0.0.0",
+			output: 0,
+		},
+		"netmask_32": {
+			input:  "255.255.255.255",
+			output: 32,
+		},
+		"wildcard": {
+			input:  "0.0.0.255",
+			output: 24,
+		},
+		"wildcard_31": {
+			input:  "0.0.0.1",
+			output: 31,
+		},
+		"ipv6_netmask": {
+			input:  "ffff:ffff:ffff:ff80::",
+			output: 57,
+		},
+		"ipv6_netmask_0": {
+			input:  "::",
+			output: 0,
+		},
+		"ipv6_netmask_128": {
+			input:  "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+			output: 128,
+		},
+		"ipv6_wildcard": {
+			input:  "::ffff:ffff:ffff:ffff",
+			output: 64,
+		},
+		"non_contiguous": {
+			input:       "255.0.255.0",
+			expectError: regexp.MustCompile("Invalid mask"),
+		},
+		"non_contiguous_wildcard": {
+			input:       "0.255.0.255",
+			expectError: regexp.MustCompile("Invalid mask"),
+		},
+		"non_contiguous_ipv6": {
+			input:       "ffff::1",
+			expectError: regexp.MustCompile("Invalid mask"),
+		},
+		"invalid": {
+			input:       "24",
+			expectError: regexp.MustCompile("Invalid mask"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::mask_bits("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::mask_bits("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.Int32Exact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = multicastMACFunction{}
+
+func newMulticastMACFunction() function.Function {
+	return multicastMACFunction{}
+}
+
+type multicastMACFunction struct{}
+
+func (f multicastMACFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "multicast_mac"
+}
+
+func (f multicastMACFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate the Ethernet multicast MAC address of a multicast group address.",
+		Description: "Generate the Ethernet multicast MAC address of a multicast group address:" +
+			" 01:00:5e with the low-order 23 bits of an IPv4 group (RFC 1112 section 6.4)" +
+			" or 33:33 with the low-order 32 bits of an IPv6 group (RFC 2464 section 7).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "group",
+				Description: "Multicast group address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f multicastMACFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	group, funcErr := parseAddressInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	if !group.IsMulticast() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid group"),
+			function.NewFuncError("address "+group.String()+" is not a multicast address"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, multicastMAC(group).String()))
+}
+
+// multicastMAC returns the Ethernet multicast MAC address of a multicast group address:
+// 01:00:5e with the low-order 23 bits of an IPv4 group
+// or 33:33 with the low-order 32 bits of an IPv6 group.
+func multicastMAC(group netip.Addr) net.HardwareAddr {
+	if group.Is4() {
+		b := group.As4()
+
+		return net.HardwareAddr{0x01, 0x00, 0x5e, b[1] & 0x7f, b[2], b[3]}
+	}
+
+	b := group.As16()
+
+	return net.HardwareAddr{0x33, 0x33, b[12], b[13], b[14], b[15]}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestMulticastMAC(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input  string
+		output string
+	}
+
+	tests := map[string]testCase{
+		"ipv4_all_hosts": {
+			input:  "224.0.0.1",
+			output: "01:00:5e:00:00:01",
+		},
+		"ipv4_high_bit_dropped": {
+			input:  "225.128.0.1",
+			output: "01:00:5e:00:00:01",
+		},
+		"ipv4_max": {
+			input:  "239.255.255.255",
+			output: "01:00:5e:7f:ff:ff",
+		},
+		"ipv6_all_nodes": {
+			input:  "ff02::1",
+			output: "33:33:00:00:00:01",
+		},
+		"ipv6_low_32_bits": {
+			input:  "ff0e::dead:beef",
+			output: "33:33:de:ad:be:ef",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mac := multicastMAC(netip.MustParseAddr(test.input))
+			if mac.String() != test.output {
+				t.Errorf("got unexpected result: want %s, got %s", test.output, mac)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionMulticastMAC(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("value must be at least 1 characters"),
+		},
+		"ipv4": {
+			input:  "224.0.0.251",
+			output: "01:00:5e:00:00:fb",
+		},
+		"ipv4_high_bit": {
+			input:  "239.255.255.250",
+			output: "01:00:5e:7f:ff:fa",
+		},
+		"ipv4_with_mask": {
+			input:  "224.128.1.1/32",
+			output: "01:00:5e:00:01:01",
+		},
+		"ipv6_solicited_node": {
+			input:  "ff02::1:ff00:5300",
+			output: "33:33:ff:00:53:00",
+		},
+		"ipv6_with_zone": {
+			input:  "ff02::fb%eth0",
+			output: "33:33:00:00:00:fb",
+		},
+		"ipv4_unicast": {
+			input:       "192.0.2.1",
+			expectError: regexp.MustCompile("is not a multicast address"),
+		},
+		"ipv6_unicast": {
+			input:       "2001:db8::1",
+			expectError: regexp.MustCompile("is not a multicast address"),
+		},
+		"invalid": {
+			input:       "ff02::zz",
+			expectError: regexp.MustCompile("unable to parse address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::multicast_mac("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::multicast_mac("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = netmaskFunction{}
+
+func newNetmaskFunction() function.Function {
+	return netmaskFunction{}
+}
+
+type netmaskFunction struct{}
+
+func (f netmaskFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "netmask"
+}
+
+func (f netmaskFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Return the netmask of a prefix length or a CIDR address.",
+		Description: "Return the netmask in address format of a prefix length" +
+			" (considered as IPv4) or of a CIDR address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "Prefix length or CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f netmaskFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	maskBits, is4, funcErr := parseMaskBitsInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, maskBitsToIPAddr(maskBits, is4).String()))
+}
+
+// parseMaskBitsInput parses a prefix length (considered as IPv4, with or without leading /)
+// or a CIDR address (as cidr function),
+// and returns the prefix length, if it's IPv4
+// or an argument error with position if the input is invalid.
+func parseMaskBitsInput(input string, position int64) (int, bool, *function.FuncError) {
+	inputBits := strings.TrimPrefix(strings.TrimSpace(input), "/")
+	if inputBits != "" && strings.Trim(inputBits, "0123456789") == "" {
+		maskBits, err := strconv.Atoi(inputBits)
+		if err != nil || maskBits > 32 {
+			return 0, false, function.ConcatFuncErrors(
+				function.NewArgumentFuncError(position, "Invalid prefix length"),
+				function.NewFuncError("must be between 0 and 32"+
+					" (a prefix length alone is considered as IPv4, use a CIDR address like ::/64 for IPv6)"),
+			)
+		}
+
+		return maskBits, true, nil
+	}
+
+	prefix, funcErr := parseCIDRInput(input, position)
+	if funcErr != nil {
+		return 0, false, funcErr
+	}
+
+	return prefix.Bits(), prefix.Addr().Is4(), nil
+}
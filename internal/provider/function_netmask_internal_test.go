@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMaskBitsInput(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input      string
+		expectBits int
+		expectIs4  bool
+		expectErr  string
+	}
+
+	tests := map[string]testCase{
+		"bits": {
+			input:      "24",
+			expectBits: 24,
+			expectIs4:  true,
+		},
+		"bits_slash": {
+			input:      " /0 ",
+			expectBits: 0,
+			expectIs4:  true,
+		},
+		"bits_ipv6": {
+			input:     "64",
+			expectErr: "must be between 0 and 32",
+		},
+		"ipv4": {
+			input:      "192.0.2.1/26",
+			expectBits: 26,
+			expectIs4:  true,
+		},
+		"ipv6": {
+			input:      "::/64",
+			expectBits: 64,
+			expectIs4:  false,
+		},
+		"non_contiguous_mask": {
+			input:     "10.0.0.0/255.0.255.0",
+			expectErr: "mask 255.0.255.0 is not a contiguous netmask or wildcard mask",
+		},
+		"wildcard_mask": {
+			input:     "10.0.0.0/0.0.0.255",
+			expectErr: "mask 0.0.0.255 is a wildcard mask instead of a netmask",
+		},
+		"invalid": {
+			input:     "24a",
+			expectErr: "unable to parse",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bits, is4, funcErr := parseMaskBitsInput(test.input, 0)
+			if test.expectErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Error(), test.expectErr) {
+					t.Errorf("got unexpected error: want %q, got %v (bits %d)", test.expectErr, funcErr, bits)
+				}
+
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("got unexpected error: %s", funcErr.Error())
+			}
+			if bits != test.expectBits {
+				t.Errorf("got unexpected bits: want %d, got %d", test.expectBits, bits)
+			}
+			if is4 != test.expectIs4 {
+				t.Errorf("got unexpected is4: want %t, got %t", test.expectIs4, is4)
+			}
+		})
+	}
+}
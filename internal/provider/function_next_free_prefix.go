@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	nextFreePrefixStrategyLowest  = "lowest"
+	nextFreePrefixStrategyHighest = "highest"
+	nextFreePrefixStrategyBestFit = "best_fit"
+)
+
+var _ function.Function = nextFreePrefixFunction{}
+
+func newNextFreePrefixFunction() function.Function {
+	return nextFreePrefixFunction{}
+}
+
+type nextFreePrefixFunction struct{}
+
+func (f nextFreePrefixFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "next_free_prefix"
+}
+
+func (f nextFreePrefixFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Find the next available prefix with a specific prefix length in a parent prefix.",
+		Description: "Return a prefix with a specific prefix length in a parent prefix" +
+			" that doesn't overlap a set of used IP addresses and prefixes.\n" +
+			" The strategy selects the lowest-addressed free prefix (default)," +
+			" the highest-addressed free prefix or the lowest free prefix of the smallest gap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "parent",
+				Description: "Parent CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.SetParameter{
+				ElementType: types.StringType,
+				Name:        "used",
+				Description: "Set of used IP addresses and prefixes",
+			},
+			function.Int32Parameter{
+				Name:        "bits",
+				Description: "Prefix length of prefix to find",
+				Validators: []function.Int32ParameterValidator{
+					int32validator.Between(0, 128),
+				},
+			},
+			function.StringParameter{
+				Name:           "strategy",
+				Description:    "(Optional) Strategy to select the prefix: `lowest`, `highest` or `best_fit`",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						nextFreePrefixStrategyLowest,
+						nextFreePrefixStrategyHighest,
+						nextFreePrefixStrategyBestFit,
+					),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f nextFreePrefixFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputParent   string
+		inputUsed     []string
+		inputBits     int32
+		inputStrategy types.String
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputParent, &inputUsed, &inputBits, &inputStrategy))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, funcErr := parseCIDRInput(inputParent, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	parent = parent.Masked()
+
+	used := make([]netip.Prefix, 0, len(inputUsed))
+	for _, item := range inputUsed {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		used = append(used, prefix)
+	}
+
+	if int(inputBits) < parent.Bits() || int(inputBits) > parent.Addr().BitLen() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, "Invalid bits"),
+			function.NewFuncError(fmt.Sprintf("must be between %d and %d for parent %s",
+				parent.Bits(), parent.Addr().BitLen(), parent.String())),
+		)
+
+		return
+	}
+
+	strategy := nextFreePrefixStrategyLowest
+	if !inputStrategy.IsNull() {
+		strategy = inputStrategy.ValueString()
+	}
+
+	output, ok := prefixesNextFree(prefixesExclude([]netip.Prefix{parent}, used), int(inputBits), strategy)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Parent exhausted"),
+			function.NewFuncError(fmt.Sprintf("no free /%d prefix in %s", inputBits, parent.String())),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// prefixesNextFree returns a prefix with a prefix length of bits
+// in a list of free prefixes sorted by address and non-overlapping (as returned by prefixesExclude),
+// selected with strategy.
+// It returns false if no free prefix can contain a prefix with a prefix length of bits.
+func prefixesNextFree(free []netip.Prefix, bits int, strategy string) (netip.Prefix, bool) {
+	switch strategy {
+	case nextFreePrefixStrategyHighest:
+		for i := len(free) - 1; i >= 0; i-- {
+			if free[i].Bits() <= bits {
+				return netip.PrefixFrom(prefixLastAddr(free[i]), bits).Masked(), true
+			}
+		}
+	case nextFreePrefixStrategyBestFit:
+		// select the lowest candidate of the smallest gap of contiguous free prefixes
+		var (
+			best     netip.Prefix
+			bestSize *big.Int
+		)
+		for i := 0; i < len(free); {
+			j := i + 1
+			for j < len(free) && prefixLastAddr(free[j-1]).Next() == free[j].Addr() {
+				j++
+			}
+
+			if candidate, ok := prefixesNextFree(free[i:j], bits, nextFreePrefixStrategyLowest); ok {
+				size := new(big.Int).Sub(addrToBigInt(prefixLastAddr(free[j-1])), addrToBigInt(free[i].Addr()))
+				if bestSize == nil || size.Cmp(bestSize) < 0 {
+					best, bestSize = candidate, size
+				}
+			}
+
+			i = j
+		}
+
+		return best, bestSize != nil
+	default:
+		for _, prefix := range free {
+			if prefix.Bits() <= bits {
+				return netip.PrefixFrom(prefix.Addr(), bits), true
+			}
+		}
+	}
+
+	return netip.Prefix{}, false
+}
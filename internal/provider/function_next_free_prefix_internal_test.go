@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestPrefixesNextFree(t *testing.T) {
+	t.Parallel()
+
+	// free space of 10.0.0.0/24 without 10.0.0.64/27 and 10.0.0.192/27:
+	// three gaps of 64, 96 and 32 addresses
+	free := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/26"),
+		netip.MustParsePrefix("10.0.0.96/27"),
+		netip.MustParsePrefix("10.0.0.128/26"),
+		netip.MustParsePrefix("10.0.0.224/27"),
+	}
+
+	type testCase struct {
+		free     []netip.Prefix
+		bits     int
+		strategy string
+		output   netip.Prefix
+		ok       bool
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			free:     []netip.Prefix{},
+			bits:     24,
+			strategy: nextFreePrefixStrategyLowest,
+		},
+		"lowest": {
+			free:     free,
+			bits:     27,
+			strategy: nextFreePrefixStrategyLowest,
+			output:   netip.MustParsePrefix("10.0.0.0/27"),
+			ok:       true,
+		},
+		"lowest_skip_smaller": {
+			free:     free,
+			bits:     26,
+			strategy: nextFreePrefixStrategyLowest,
+			output:   netip.MustParsePrefix("10.0.0.0/26"),
+			ok:       true,
+		},
+		"highest": {
+			free:     free,
+			bits:     28,
+			strategy: nextFreePrefixStrategyHighest,
+			output:   netip.MustParsePrefix("10.0.0.240/28"),
+			ok:       true,
+		},
+		"highest_skip_smaller": {
+			free:     free,
+			bits:     26,
+			strategy: nextFreePrefixStrategyHighest,
+			output:   netip.MustParsePrefix("10.0.0.128/26"),
+			ok:       true,
+		},
+		"best_fit": {
+			free:     free,
+			bits:     27,
+			strategy: nextFreePrefixStrategyBestFit,
+			output:   netip.MustParsePrefix("10.0.0.224/27"),
+			ok:       true,
+		},
+		"best_fit_skip_smaller": {
+			free:     free,
+			bits:     26,
+			strategy: nextFreePrefixStrategyBestFit,
+			output:   netip.MustParsePrefix("10.0.0.0/26"),
+			ok:       true,
+		},
+		"best_fit_contiguous_gap": {
+			free:     free,
+			bits:     25,
+			strategy: nextFreePrefixStrategyBestFit,
+		},
+		"best_fit_end_of_space": {
+			free: []netip.Prefix{
+				netip.MustParsePrefix("255.255.255.0/25"),
+				netip.MustParsePrefix("255.255.255.192/26"),
+			},
+			bits:     26,
+			strategy: nextFreePrefixStrategyBestFit,
+			output:   netip.MustParsePrefix("255.255.255.192/26"),
+			ok:       true,
+		},
+		"ipv6_highest": {
+			free: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8:0:1::/64"),
+				netip.MustParsePrefix("2001:db8:0:2::/63"),
+			},
+			bits:     64,
+			strategy: nextFreePrefixStrategyHighest,
+			output:   netip.MustParsePrefix("2001:db8:0:3::/64"),
+			ok:       true,
+		},
+		"too_small": {
+			free:     free,
+			bits:     24,
+			strategy: nextFreePrefixStrategyLowest,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := prefixesNextFree(test.free, test.bits, test.strategy)
+			if ok != test.ok {
+				t.Fatalf("got unexpected ok: want %t, got %t", test.ok, ok)
+			}
+			if result != test.output {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
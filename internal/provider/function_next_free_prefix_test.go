@@ -0,0 +1,189 @@
+package provider_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionNextFreePrefix(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		parent      string
+		used        string
+		bits        int
+		strategy    string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty_parent": {
+			parent:      "",
+			used:        `[]`,
+			bits:        24,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_parent": {
+			parent:      "10.0.0.a/24",
+			used:        `[]`,
+			bits:        26,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_used": {
+			parent:      "10.0.0.0/24",
+			used:        `["10.0.0.a"]`,
+			bits:        26,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+		"invalid_strategy": {
+			parent:      "10.0.0.0/24",
+			used:        `[]`,
+			bits:        26,
+			strategy:    `"first"`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"ipv4_bits_too_small": {
+			parent:      "10.0.0.0/24",
+			used:        `[]`,
+			bits:        23,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Invalid bits"),
+		},
+		"ipv4_bits_too_big": {
+			parent:      "10.0.0.0/24",
+			used:        `[]`,
+			bits:        33,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Invalid bits"),
+		},
+		"ipv4_empty_used": {
+			parent:   "10.0.0.1/24",
+			used:     `[]`,
+			bits:     26,
+			strategy: `null`,
+			output:   "10.0.0.0/26",
+		},
+		"ipv4_default": {
+			parent:   "10.0.0.0/24",
+			used:     `["10.0.0.0/27", "10.0.0.64/27", "10.0.0.192/27"]`,
+			bits:     27,
+			strategy: `null`,
+			output:   "10.0.0.32/27",
+		},
+		"ipv4_lowest": {
+			parent:   "10.0.0.0/24",
+			used:     `["10.0.0.64/27", "10.0.0.192/27"]`,
+			bits:     27,
+			strategy: `"lowest"`,
+			output:   "10.0.0.0/27",
+		},
+		"ipv4_highest": {
+			parent:   "10.0.0.0/24",
+			used:     `["10.0.0.64/27", "10.0.0.192/27"]`,
+			bits:     28,
+			strategy: `"highest"`,
+			output:   "10.0.0.240/28",
+		},
+		"ipv4_best_fit": {
+			parent:   "10.0.0.0/24",
+			used:     `["10.0.0.64/27", "10.0.0.192/27"]`,
+			bits:     27,
+			strategy: `"best_fit"`,
+			output:   "10.0.0.224/27",
+		},
+		"ipv4_best_fit_skip_smaller": {
+			parent:   "10.0.0.0/24",
+			used:     `["10.0.0.64/27", "10.0.0.192/27"]`,
+			bits:     26,
+			strategy: `"best_fit"`,
+			output:   "10.0.0.0/26",
+		},
+		"ipv4_address": {
+			parent:   "10.0.0.0/29",
+			used:     `["10.0.0.1", "10.0.0.2"]`,
+			bits:     30,
+			strategy: `null`,
+			output:   "10.0.0.4/30",
+		},
+		"ipv4_exhausted": {
+			parent:      "10.0.0.0/24",
+			used:        `["10.0.0.0/25", "10.0.0.192/26"]`,
+			bits:        25,
+			strategy:    `null`,
+			expectError: regexp.MustCompile("Parent exhausted"),
+		},
+		"ipv6": {
+			parent:   "2001:db8::/48",
+			used:     `["2001:db8::/64", "2001:db8:0:1::1"]`,
+			bits:     64,
+			strategy: `null`,
+			output:   "2001:db8:0:2::/64",
+		},
+		"ipv6_highest": {
+			parent:   "2001:db8::/48",
+			used:     `["2001:db8:0:ffff::/64"]`,
+			bits:     64,
+			strategy: `"highest"`,
+			output:   "2001:db8:0:fffe::/64",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::next_free_prefix("` + test.parent + `", ` +
+								`toset(` + test.used + `), ` + strconv.Itoa(test.bits) + `, ` + test.strategy + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::next_free_prefix("` + test.parent + `", ` +
+								`toset(` + test.used + `), ` + strconv.Itoa(test.bits) + `, ` + test.strategy + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = overlapsFunction{}
+
+func newOverlapsFunction() function.Function {
+	return overlapsFunction{}
+}
+
+type overlapsFunction struct{}
+
+type overlapsFunctionPair struct {
+	InputA string `tfsdk:"input_a"`
+	IndexA int64  `tfsdk:"index_a"`
+	InputB string `tfsdk:"input_b"`
+	IndexB int64  `tfsdk:"index_b"`
+}
+
+func (f overlapsFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "overlaps"
+}
+
+func (f overlapsFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Detect overlapping entries in a list of IP addresses and prefixes.",
+		Description: "Return every pair of entries in a list of IP addresses and prefixes" +
+			" whose address space intersects, with the original strings and indexes.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "List of IP addresses and prefixes to check",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"input_a": types.StringType,
+					"index_a": types.Int64Type,
+					"input_b": types.StringType,
+					"index_b": types.Int64Type,
+				},
+			},
+		},
+	}
+}
+
+func (f overlapsFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(inputs))
+	for _, item := range inputs {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	overlaps := prefixesOverlaps(prefixes)
+
+	result := make([]overlapsFunctionPair, len(overlaps))
+	for i, pair := range overlaps {
+		result[i] = overlapsFunctionPair{
+			InputA: inputs[pair[0]],
+			IndexA: int64(pair[0]),
+			InputB: inputs[pair[1]],
+			IndexB: int64(pair[1]),
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// prefixesOverlaps returns the pairs of indexes of overlapping prefixes,
+// sorted by first index then second index (first index is always lower than second index).
+//
+// Two prefixes overlap only if one contains the other,
+// so prefixes are sorted and swept with a stack of containing prefixes
+// instead of comparing all pairs.
+func prefixesOverlaps(prefixes []netip.Prefix) [][2]int {
+	type entry struct {
+		prefix netip.Prefix
+		index  int
+	}
+
+	entries := make([]entry, len(prefixes))
+	for i, prefix := range prefixes {
+		entries[i] = entry{prefix: prefix.Masked(), index: i}
+	}
+
+	// sort by address then by prefix length (shortest first)
+	// so a prefix is always after the prefixes which contain it
+	slices.SortFunc(entries, func(a, b entry) int {
+		if c := a.prefix.Addr().Compare(b.prefix.Addr()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.prefix.Bits(), b.prefix.Bits()); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.index, b.index)
+	})
+
+	pairs := make([][2]int, 0)
+	stack := make([]entry, 0)
+	for _, e := range entries {
+		// remove prefixes which end before current prefix
+		// (also happen when prefixes have different IP version)
+		for len(stack) > 0 && !stack[len(stack)-1].prefix.Contains(e.prefix.Addr()) {
+			stack = stack[:len(stack)-1]
+		}
+
+		// all prefixes of stack contain current prefix
+		for _, s := range stack {
+			pairs = append(pairs, [2]int{min(s.index, e.index), max(s.index, e.index)})
+		}
+
+		stack = append(stack, e)
+	}
+
+	slices.SortFunc(pairs, func(a, b [2]int) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a[1], b[1])
+	})
+
+	return pairs
+}
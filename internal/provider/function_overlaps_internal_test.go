@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixesOverlaps(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes []netip.Prefix
+		output   [][2]int
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixes: []netip.Prefix{},
+			output:   [][2]int{},
+		},
+		"no_overlap": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("::/0"),
+			},
+			output: [][2]int{},
+		},
+		"nested": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("10.0.0.0/8"),
+				netip.MustParsePrefix("10.0.0.0/16"),
+				netip.MustParsePrefix("10.0.1.1/32"),
+			},
+			output: [][2]int{
+				{0, 1},
+				{0, 2},
+				{0, 3},
+				{1, 2},
+				{1, 3},
+				{2, 3},
+			},
+		},
+		"siblings_in_container": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/25"),
+				netip.MustParsePrefix("10.0.0.128/25"),
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.1.0/24"),
+			},
+			output: [][2]int{
+				{0, 2},
+				{1, 2},
+			},
+		},
+		"duplicates": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/32"),
+				netip.MustParsePrefix("2001:db8::/32"),
+				netip.MustParsePrefix("2001:db8::/32"),
+			},
+			output: [][2]int{
+				{0, 1},
+				{0, 2},
+				{1, 2},
+			},
+		},
+		"mixed_families": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/32"),
+				netip.MustParsePrefix("0.0.0.0/0"),
+				netip.MustParsePrefix("2001:db8::1/128"),
+				netip.MustParsePrefix("192.0.2.1/32"),
+			},
+			output: [][2]int{
+				{0, 2},
+				{1, 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixesOverlaps(test.prefixes)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
+
+func BenchmarkPrefixesOverlaps(b *testing.B) {
+	prefixes := make([]netip.Prefix, 0, 4096)
+	for i := range 4096 {
+		prefixes = append(prefixes, netip.PrefixFrom(netip.AddrFrom4([4]byte{10, byte(i >> 4), byte(i << 4), 0}), 24))
+	}
+
+	for b.Loop() {
+		prefixesOverlaps(prefixes)
+	}
+}
@@ -0,0 +1,174 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionOverlaps(t *testing.T) {
+	t.Parallel()
+
+	type testPair struct {
+		inputA string
+		indexA int64
+		inputB string
+		indexB int64
+	}
+
+	type testCase struct {
+		input       []string
+		expectError *regexp.Regexp
+		output      []testPair
+	}
+
+	tests := map[string]testCase{
+		"no_overlap": {
+			input: []string{
+				"10.0.0.0/16",
+				"10.1.0.0/16",
+				"2001:db8::/32",
+			},
+			output: []testPair{},
+		},
+		"ipv4": {
+			input: []string{
+				"10.0.0.0/16",
+				"10.1.0.0/16",
+				"10.0.5.0/24",
+			},
+			output: []testPair{
+				{inputA: "10.0.0.0/16", indexA: 0, inputB: "10.0.5.0/24", indexB: 2},
+			},
+		},
+		"ipv4_nested": {
+			input: []string{
+				"10.0.5.1",
+				"10.0.0.0/16",
+				"10.0.0.0/8",
+			},
+			output: []testPair{
+				{inputA: "10.0.5.1", indexA: 0, inputB: "10.0.0.0/16", indexB: 1},
+				{inputA: "10.0.5.1", indexA: 0, inputB: "10.0.0.0/8", indexB: 2},
+				{inputA: "10.0.0.0/16", indexA: 1, inputB: "10.0.0.0/8", indexB: 2},
+			},
+		},
+		"ipv4_not_canonical": {
+			input: []string{
+				"10.0.5.1/16",
+				"10.0.200.0/24",
+			},
+			output: []testPair{
+				{inputA: "10.0.5.1/16", indexA: 0, inputB: "10.0.200.0/24", indexB: 1},
+			},
+		},
+		"duplicate": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.0.0/24",
+			},
+			output: []testPair{
+				{inputA: "10.0.0.0/24", indexA: 0, inputB: "10.0.0.0/24", indexB: 1},
+			},
+		},
+		"ipv6": {
+			input: []string{
+				"2001:db8::/48",
+				"2001:db8::/32",
+				"2001:db9::/32",
+			},
+			output: []testPair{
+				{inputA: "2001:db8::/48", indexA: 0, inputB: "2001:db8::/32", indexB: 1},
+			},
+		},
+		"mixed_families": {
+			input: []string{
+				"0.0.0.0/0",
+				"::/0",
+				"::ffff:10.0.0.1",
+			},
+			output: []testPair{
+				{inputA: "::/0", indexA: 1, inputB: "::ffff:10.0.0.1", indexB: 2},
+			},
+		},
+		"empty": {
+			input:  []string{},
+			output: []testPair{},
+		},
+		"invalid": {
+			input: []string{
+				"10.0.0.0/16",
+				"10.0.0.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedInput := make([]string, len(test.input))
+			for i, v := range test.input {
+				quotedInput[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::overlaps([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"input_a": knownvalue.StringExact(v.inputA),
+						"index_a": knownvalue.Int64Exact(v.indexA),
+						"input_b": knownvalue.StringExact(v.inputB),
+						"index_b": knownvalue.Int64Exact(v.indexB),
+					})
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::overlaps([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
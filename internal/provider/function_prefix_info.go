@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = prefixInfoFunction{}
+
+func newPrefixInfoFunction() function.Function {
+	return prefixInfoFunction{}
+}
+
+type prefixInfoFunction struct{}
+
+type prefixInfoFunctionResult struct {
+	Prefix          string `tfsdk:"prefix"`
+	Network         string `tfsdk:"network"`
+	LastAddress     string `tfsdk:"last_address"`
+	FirstHost       string `tfsdk:"first_host"`
+	LastHost        string `tfsdk:"last_host"`
+	Netmask         string `tfsdk:"netmask"`
+	Wildcard        string `tfsdk:"wildcard"`
+	Bits            int32  `tfsdk:"bits"`
+	Family          string `tfsdk:"family"`
+	TotalAddresses  string `tfsdk:"total_addresses"`
+	UsableAddresses string `tfsdk:"usable_addresses"`
+}
+
+func (f prefixInfoFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "prefix_info"
+}
+
+func (f prefixInfoFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Describe a prefix.",
+		Description: "Return an object describing a prefix" +
+			" (network and last addresses, usable hosts, netmask, wildcard mask, prefix length," +
+			" address family and address counts).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"prefix":           types.StringType,
+				"network":          types.StringType,
+				"last_address":     types.StringType,
+				"first_host":       types.StringType,
+				"last_host":        types.StringType,
+				"netmask":          types.StringType,
+				"wildcard":         types.StringType,
+				"bits":             types.Int32Type,
+				"family":           types.StringType,
+				"total_addresses":  types.StringType,
+				"usable_addresses": types.StringType,
+			},
+		},
+	}
+}
+
+func (f prefixInfoFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	prefix = prefix.Masked()
+
+	lastAddress := prefixLastAddr(prefix)
+	// network and broadcast addresses are not usable only in IPv4 prefixes shorter than /31
+	firstHost, lastHost := prefixHostRange(prefix, true, true)
+	netmask := maskBitsToIPAddr(prefix.Bits(), prefix.Addr().Is4())
+
+	family := "ipv6"
+	if prefix.Addr().Is4() {
+		family = "ipv4"
+	}
+
+	totalAddresses := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+	usableAddresses := new(big.Int).Sub(addrToBigInt(lastHost), addrToBigInt(firstHost))
+	usableAddresses.Add(usableAddresses, big.NewInt(1))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefixInfoFunctionResult{
+		Prefix:          prefix.String(),
+		Network:         prefix.Addr().String(),
+		LastAddress:     lastAddress.String(),
+		FirstHost:       firstHost.String(),
+		LastHost:        lastHost.String(),
+		Netmask:         netmask.String(),
+		Wildcard:        ipAddrMaskString(ipAddrInvert(netmask)),
+		Bits:            int32(prefix.Bits()),
+		Family:          family,
+		TotalAddresses:  totalAddresses.String(),
+		UsableAddresses: usableAddresses.String(),
+	}))
+}
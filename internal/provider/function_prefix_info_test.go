@@ -0,0 +1,221 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionPrefixInfo(t *testing.T) {
+	t.Parallel()
+
+	type testOutput struct {
+		prefix          string
+		network         string
+		lastAddress     string
+		firstHost       string
+		lastHost        string
+		netmask         string
+		wildcard        string
+		bits            int32
+		family          string
+		totalAddresses  string
+		usableAddresses string
+	}
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      testOutput
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"space": {
+			input:       " ",
+			expectError: regexp.MustCompile(`String only with space character\(s\)`),
+		},
+		"invalid": {
+			input:       "192.0.2.a/24",
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"ipv4": {
+			input: "192.0.2.130/25",
+			output: testOutput{
+				prefix:          "192.0.2.128/25",
+				network:         "192.0.2.128",
+				lastAddress:     "192.0.2.255",
+				firstHost:       "192.0.2.129",
+				lastHost:        "192.0.2.254",
+				netmask:         "255.255.255.128",
+				wildcard:        "0.0.0.127",
+				bits:            25,
+				family:          "ipv4",
+				totalAddresses:  "128",
+				usableAddresses: "126",
+			},
+		},
+		"ipv4_netmask": {
+			input: "10.1/255.255.0.0",
+			output: testOutput{
+				prefix:          "10.1.0.0/16",
+				network:         "10.1.0.0",
+				lastAddress:     "10.1.255.255",
+				firstHost:       "10.1.0.1",
+				lastHost:        "10.1.255.254",
+				netmask:         "255.255.0.0",
+				wildcard:        "0.0.255.255",
+				bits:            16,
+				family:          "ipv4",
+				totalAddresses:  "65536",
+				usableAddresses: "65534",
+			},
+		},
+		"ipv4_point_to_point": {
+			input: "10.0.0.0/31",
+			output: testOutput{
+				prefix:          "10.0.0.0/31",
+				network:         "10.0.0.0",
+				lastAddress:     "10.0.0.1",
+				firstHost:       "10.0.0.0",
+				lastHost:        "10.0.0.1",
+				netmask:         "255.255.255.254",
+				wildcard:        "0.0.0.1",
+				bits:            31,
+				family:          "ipv4",
+				totalAddresses:  "2",
+				usableAddresses: "2",
+			},
+		},
+		"ipv4_host": {
+			input: "10.0.0.1",
+			output: testOutput{
+				prefix:          "10.0.0.1/32",
+				network:         "10.0.0.1",
+				lastAddress:     "10.0.0.1",
+				firstHost:       "10.0.0.1",
+				lastHost:        "10.0.0.1",
+				netmask:         "255.255.255.255",
+				wildcard:        "0.0.0.0",
+				bits:            32,
+				family:          "ipv4",
+				totalAddresses:  "1",
+				usableAddresses: "1",
+			},
+		},
+		"ipv6": {
+			input: "2001:db8::1/64",
+			output: testOutput{
+				prefix:          "2001:db8::/64",
+				network:         "2001:db8::",
+				lastAddress:     "2001:db8::ffff:ffff:ffff:ffff",
+				firstHost:       "2001:db8::",
+				lastHost:        "2001:db8::ffff:ffff:ffff:ffff",
+				netmask:         "ffff:ffff:ffff:ffff::",
+				wildcard:        "::ffff:ffff:ffff:ffff",
+				bits:            64,
+				family:          "ipv6",
+				totalAddresses:  "18446744073709551616",
+				usableAddresses: "18446744073709551616",
+			},
+		},
+		"ipv6_80": {
+			input: "2001:db8::1/80",
+			output: testOutput{
+				prefix:          "2001:db8::/80",
+				network:         "2001:db8::",
+				lastAddress:     "2001:db8::ffff:ffff:ffff",
+				firstHost:       "2001:db8::",
+				lastHost:        "2001:db8::ffff:ffff:ffff",
+				netmask:         "ffff:ffff:ffff:ffff:ffff::",
+				wildcard:        "::ffff:ffff:ffff",
+				bits:            80,
+				family:          "ipv6",
+				totalAddresses:  "281474976710656",
+				usableAddresses: "281474976710656",
+			},
+		},
+		"ipv6_all": {
+			input: "::/0",
+			output: testOutput{
+				prefix:          "::/0",
+				network:         "::",
+				lastAddress:     "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+				firstHost:       "::",
+				lastHost:        "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+				netmask:         "::",
+				wildcard:        "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+				bits:            0,
+				family:          "ipv6",
+				totalAddresses:  "340282366920938463463374607431768211456",
+				usableAddresses: "340282366920938463463374607431768211456",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefix_info("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefix_info("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"prefix":           knownvalue.StringExact(test.output.prefix),
+										"network":          knownvalue.StringExact(test.output.network),
+										"last_address":     knownvalue.StringExact(test.output.lastAddress),
+										"first_host":       knownvalue.StringExact(test.output.firstHost),
+										"last_host":        knownvalue.StringExact(test.output.lastHost),
+										"netmask":          knownvalue.StringExact(test.output.netmask),
+										"wildcard":         knownvalue.StringExact(test.output.wildcard),
+										"bits":             knownvalue.Int32Exact(test.output.bits),
+										"family":           knownvalue.StringExact(test.output.family),
+										"total_addresses":  knownvalue.StringExact(test.output.totalAddresses),
+										"usable_addresses": knownvalue.StringExact(test.output.usableAddresses),
+									}),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
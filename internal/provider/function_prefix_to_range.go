@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = prefixToRangeFunction{}
+
+func newPrefixToRangeFunction() function.Function {
+	return prefixToRangeFunction{}
+}
+
+type prefixToRangeFunction struct{}
+
+// addressRangeObject is the object returned for a range of IP addresses.
+type addressRangeObject struct {
+	Start string `tfsdk:"start"`
+	End   string `tfsdk:"end"`
+}
+
+func (f prefixToRangeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "prefix_to_range"
+}
+
+func (f prefixToRangeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a CIDR prefix to an IP range.",
+		Description: "Return the start and end addresses of the range of IP addresses covered by a CIDR prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"start": types.StringType,
+				"end":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f prefixToRangeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, addressRangeObject{
+		Start: prefix.Masked().Addr().String(),
+		End:   prefixLastAddr(prefix).String(),
+	}))
+}
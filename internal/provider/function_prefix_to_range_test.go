@@ -0,0 +1,115 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionPrefixToRange(t *testing.T) {
+	t.Parallel()
+
+	type testOutput struct {
+		start string
+		end   string
+	}
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      testOutput
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid": {
+			input:       "192.0.2.a/24",
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"ipv4": {
+			input: "192.0.2.130/25",
+			output: testOutput{
+				start: "192.0.2.128",
+				end:   "192.0.2.255",
+			},
+		},
+		"ipv4_host": {
+			input: "10.0.0.1/32",
+			output: testOutput{
+				start: "10.0.0.1",
+				end:   "10.0.0.1",
+			},
+		},
+		"ipv4_all": {
+			input: "0.0.0.0/0",
+			output: testOutput{
+				start: "0.0.0.0",
+				end:   "255.255.255.255",
+			},
+		},
+		"ipv6": {
+			input: "2001:db8::1/64",
+			output: testOutput{
+				start: "2001:db8::",
+				end:   "2001:db8::ffff:ffff:ffff:ffff",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefix_to_range("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefix_to_range("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"start": knownvalue.StringExact(test.output.start),
+										"end":   knownvalue.StringExact(test.output.end),
+									}),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = prefixesToRangesFunction{}
+
+func newPrefixesToRangesFunction() function.Function {
+	return prefixesToRangesFunction{}
+}
+
+type prefixesToRangesFunction struct{}
+
+func (f prefixesToRangesFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "prefixes_to_ranges"
+}
+
+func (f prefixesToRangesFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a list of IP addresses and prefixes to IP ranges.",
+		Description: "Merge adjacent and overlapping IP addresses and prefixes of a list" +
+			" and return the fewest ranges of contiguous IP addresses that cover the same addresses.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "List of IP addresses and prefixes to convert",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"start": types.StringType,
+					"end":   types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func (f prefixesToRangesFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(inputs))
+	for _, item := range inputs {
+		prefix, funcErr := parsePrefixOrAddressInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	ranges := prefixesToRanges(prefixes)
+
+	result := make([]addressRangeObject, len(ranges))
+	for i, r := range ranges {
+		result[i] = addressRangeObject{
+			Start: r[0].String(),
+			End:   r[1].String(),
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// prefixesToRanges returns, sorted by address, the fewest ranges [start, end]
+// of contiguous IP addresses that cover the IP space of prefixes.
+func prefixesToRanges(prefixes []netip.Prefix) [][2]netip.Addr {
+	// summarize to have sorted and non-overlapping prefixes
+	prefixes = prefixesSummarize(prefixes)
+
+	ranges := make([][2]netip.Addr, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		start := prefix.Addr()
+		end := prefixLastAddr(prefix)
+
+		// merge with previous range if contiguous
+		// (prefixes are sorted with IPv4 before IPv6 and Next of an IPv4 address is never an IPv6 address,
+		// so an IPv4 range is never merged with an IPv6 range)
+		if last := len(ranges) - 1; last >= 0 && ranges[last][1].Next() == start {
+			ranges[last][1] = end
+
+			continue
+		}
+
+		ranges = append(ranges, [2]netip.Addr{start, end})
+	}
+
+	return ranges
+}
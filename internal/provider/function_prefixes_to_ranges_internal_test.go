@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixesToRanges(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefixes []netip.Prefix
+		output   [][2]netip.Addr
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefixes: []netip.Prefix{},
+			output:   [][2]netip.Addr{},
+		},
+		"single": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.1/24"),
+			},
+			output: [][2]netip.Addr{
+				{netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("10.0.0.255")},
+			},
+		},
+		"adjacent_not_aligned": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.1.0/24"),
+				netip.MustParsePrefix("10.0.2.0/24"),
+				netip.MustParsePrefix("10.0.3.0/32"),
+			},
+			output: [][2]netip.Addr{
+				{netip.MustParseAddr("10.0.1.0"), netip.MustParseAddr("10.0.3.0")},
+			},
+		},
+		"overlapping": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.0.0/23"),
+			},
+			output: [][2]netip.Addr{
+				{netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("10.0.1.255")},
+			},
+		},
+		"gap": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.2.0/24"),
+			},
+			output: [][2]netip.Addr{
+				{netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("10.0.0.255")},
+				{netip.MustParseAddr("10.0.2.0"), netip.MustParseAddr("10.0.2.255")},
+			},
+		},
+		"ipv4_end_and_ipv6_start": {
+			prefixes: []netip.Prefix{
+				netip.MustParsePrefix("::/1"),
+				netip.MustParsePrefix("128.0.0.0/1"),
+			},
+			output: [][2]netip.Addr{
+				{netip.MustParseAddr("128.0.0.0"), netip.MustParseAddr("255.255.255.255")},
+				{netip.MustParseAddr("::"), netip.MustParseAddr("7fff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := prefixesToRanges(test.prefixes)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionPrefixesToRanges(t *testing.T) {
+	t.Parallel()
+
+	type testRange struct {
+		start string
+		end   string
+	}
+
+	type testCase struct {
+		input       []string
+		expectError *regexp.Regexp
+		output      []testRange
+	}
+
+	tests := map[string]testCase{
+		"adjacent": {
+			input: []string{
+				"10.0.1.0/24",
+				"10.0.0.0/24",
+				"10.0.2.0/25",
+			},
+			output: []testRange{
+				{start: "10.0.0.0", end: "10.0.2.127"},
+			},
+		},
+		"overlapping": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.0.0/23",
+				"10.0.1.1",
+			},
+			output: []testRange{
+				{start: "10.0.0.0", end: "10.0.1.255"},
+			},
+		},
+		"gap": {
+			input: []string{
+				"192.0.2.0/26",
+				"192.0.2.128/26",
+				"192.0.2.192",
+			},
+			output: []testRange{
+				{start: "192.0.2.0", end: "192.0.2.63"},
+				{start: "192.0.2.128", end: "192.0.2.192"},
+			},
+		},
+		"mixed": {
+			input: []string{
+				"2001:db8::/64",
+				"2001:db8:0:1::/64",
+				"10.0.0.0/24",
+			},
+			output: []testRange{
+				{start: "10.0.0.0", end: "10.0.0.255"},
+				{start: "2001:db8::", end: "2001:db8::1:ffff:ffff:ffff:ffff"},
+			},
+		},
+		"empty": {
+			input:  []string{},
+			output: []testRange{},
+		},
+		"invalid": {
+			input: []string{
+				"10.0.0.0/16",
+				"10.0.0.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedInput := make([]string, len(test.input))
+			for i, v := range test.input {
+				quotedInput[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefixes_to_ranges([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"start": knownvalue.StringExact(v.start),
+						"end":   knownvalue.StringExact(v.end),
+					})
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::prefixes_to_ranges([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
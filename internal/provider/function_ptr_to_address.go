@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	ptrSuffixIPv4 = "in-addr.arpa"
+	ptrSuffixIPv6 = "ip6.arpa"
+)
+
+var _ function.Function = ptrToAddressFunction{}
+
+func newPtrToAddressFunction() function.Function {
+	return ptrToAddressFunction{}
+}
+
+type ptrToAddressFunction struct{}
+
+func (f ptrToAddressFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "ptr_to_address"
+}
+
+func (f ptrToAddressFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate the address from a PTR name.",
+		Description: "Generate the address from a PTR name with 'in-addr.arpa.' or 'ip6.arpa.' suffix" +
+			" (inverse of ptr function).\n" +
+			" A partial name (reverse DNS zone name) returns a prefix in CIDR format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "PTR name to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ptrToAddressFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parsePtrNameInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	if prefix.IsSingleIP() {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefix.Addr().String()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefix.String()))
+}
+
+// parsePtrNameInput parses a PTR name (or a partial name) with 'in-addr.arpa.' or 'ip6.arpa.' suffix
+// to a prefix (with a prefix length of address length for a full name)
+// and returns an argument error with position if the input is invalid.
+//
+// The name is case insensitive, the trailing dot is optional
+// and the first label of an IPv4 name with 4 labels can be a RFC 2317 range (e.g. 0-63.2.0.192.in-addr.arpa.).
+func parsePtrNameInput(input string, position int64) (netip.Prefix, *function.FuncError) {
+	invalidName := func(detail string) *function.FuncError {
+		return function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid PTR name"),
+			function.NewFuncError("unable to parse PTR name input "+strconv.Quote(input)+": "+detail),
+		)
+	}
+
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(input)), ".")
+
+	var labels []string
+	var is4 bool
+	switch {
+	case name == ptrSuffixIPv4:
+		is4 = true
+	case name == ptrSuffixIPv6:
+	case strings.HasSuffix(name, "."+ptrSuffixIPv4):
+		is4 = true
+		labels = strings.Split(strings.TrimSuffix(name, "."+ptrSuffixIPv4), ".")
+	case strings.HasSuffix(name, "."+ptrSuffixIPv6):
+		labels = strings.Split(strings.TrimSuffix(name, "."+ptrSuffixIPv6), ".")
+	default:
+		return netip.Prefix{}, invalidName("must end with " + ptrSuffixIPv4 + " or " + ptrSuffixIPv6)
+	}
+
+	// labels are in reverse order of address parts
+	slices.Reverse(labels)
+
+	if is4 {
+		if len(labels) > 4 {
+			return netip.Prefix{}, invalidName("too many labels before " + ptrSuffixIPv4 + " (maximum 4)")
+		}
+
+		var b [4]byte
+		for i, label := range labels {
+			if i == 3 && strings.Contains(label, "-") {
+				return parsePtrNameRFC2317(b, label, invalidName)
+			}
+
+			v, err := strconv.ParseUint(label, 10, 8)
+			if err != nil {
+				return netip.Prefix{}, invalidName("label " + strconv.Quote(label) +
+					" is not an octet in decimal (0-255)")
+			}
+			b[i] = byte(v)
+		}
+
+		return netip.PrefixFrom(netip.AddrFrom4(b), len(labels)*8), nil
+	}
+
+	if len(labels) > 32 {
+		return netip.Prefix{}, invalidName("too many labels before " + ptrSuffixIPv6 + " (maximum 32)")
+	}
+
+	var b [16]byte
+	for i, label := range labels {
+		v, err := strconv.ParseUint(label, 16, 4)
+		if err != nil || len(label) != 1 {
+			return netip.Prefix{}, invalidName("label " + strconv.Quote(label) +
+				" is not a nibble in hexadecimal (0-f)")
+		}
+		if i%2 == 0 {
+			b[i/2] = byte(v) << 4
+		} else {
+			b[i/2] |= byte(v)
+		}
+	}
+
+	return netip.PrefixFrom(netip.AddrFrom16(b), len(labels)*4), nil
+}
+
+// parsePtrNameRFC2317 parses the RFC 2317 range label (first-last) of an IPv4 PTR name
+// with the first 3 octets of address in b.
+// The range must be a block of addresses aligned on its size.
+func parsePtrNameRFC2317(
+	b [4]byte, label string, invalidName func(string) *function.FuncError,
+) (netip.Prefix, *function.FuncError) {
+	inputFirst, inputLast, _ := strings.Cut(label, "-")
+
+	first, errFirst := strconv.ParseUint(inputFirst, 10, 8)
+	last, errLast := strconv.ParseUint(inputLast, 10, 8)
+	if errFirst != nil || errLast != nil {
+		return netip.Prefix{}, invalidName("label " + strconv.Quote(label) +
+			" is not a range of octets in decimal (0-255)")
+	}
+
+	if first > last {
+		return netip.Prefix{}, invalidName("label " + strconv.Quote(label) +
+			" is not a range of octets in order")
+	}
+
+	b[3] = byte(first)
+	start := netip.AddrFrom4(b)
+	b[3] = byte(last)
+	end := netip.AddrFrom4(b)
+
+	prefixes := rangeToPrefixes(start, end)
+	if len(prefixes) != 1 {
+		return netip.Prefix{}, invalidName("label " + strconv.Quote(label) +
+			" is not a range of a prefix")
+	}
+
+	return prefixes[0], nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParsePtrNameInput(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input     string
+		expectErr bool
+		output    netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			input:  "255.200.0.192.in-addr.arpa.",
+			output: netip.MustParsePrefix("192.0.200.255/32"),
+		},
+		"ipv4_partial": {
+			input:  "10.in-addr.arpa",
+			output: netip.MustParsePrefix("10.0.0.0/8"),
+		},
+		"ipv4_rfc2317_host": {
+			input:  "5-5.2.0.192.in-addr.arpa",
+			output: netip.MustParsePrefix("192.0.2.5/32"),
+		},
+		"ipv4_rfc2317_all": {
+			input:  "0-255.2.0.192.in-addr.arpa",
+			output: netip.MustParsePrefix("192.0.2.0/24"),
+		},
+		"ipv6": {
+			input:  "1.0.0.0.0.0.0.0.0.0.0.0.a.0.0.0.a.b.9.a.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			output: netip.MustParsePrefix("2001:db8:0:a9ba:a::1/128"),
+		},
+		"ipv6_partial_odd": {
+			input:  "a.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			output: netip.MustParsePrefix("2001:db8:a::/48"),
+		},
+		"ipv6_partial_nibble": {
+			input:  "f.8.b.d.0.1.0.0.2.ip6.arpa.",
+			output: netip.MustParsePrefix("2001:db8:f000::/36"),
+		},
+		"ipv6_suffix_only": {
+			input:  "ip6.arpa.",
+			output: netip.MustParsePrefix("::/0"),
+		},
+		"invalid_rfc2317_order": {
+			input:     "63-0.2.0.192.in-addr.arpa.",
+			expectErr: true,
+		},
+		"invalid_rfc2317_octet": {
+			input:     "0-256.2.0.192.in-addr.arpa.",
+			expectErr: true,
+		},
+		"invalid_rfc2317_not_first": {
+			input:     "0-63.0.192.in-addr.arpa.",
+			expectErr: true,
+		},
+		"invalid_sign": {
+			input:     "+1.2.0.192.in-addr.arpa.",
+			expectErr: true,
+		},
+		"invalid_ipv6_too_many_labels": {
+			input:     "0.1.0.0.0.0.0.0.0.0.0.0.0.a.0.0.0.a.b.9.a.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			expectErr: true,
+		},
+		"invalid_double_trailing_dot": {
+			input:     "1.2.0.192.in-addr.arpa..",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, funcErr := parsePtrNameInput(test.input, 0)
+			if test.expectErr {
+				if funcErr == nil {
+					t.Errorf("expected error, got %s", prefix)
+				}
+
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Error())
+			}
+			if prefix != test.output {
+				t.Errorf("got unexpected result: want %s, got %s", test.output, prefix)
+			}
+		})
+	}
+}
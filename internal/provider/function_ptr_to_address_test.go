@@ -0,0 +1,139 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionPtrToAddress(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"ipv4": {
+			input:  "1.2.0.192.in-addr.arpa.",
+			output: "192.0.2.1",
+		},
+		"ipv4_without_trailing_dot": {
+			input:  "128.2.0.192.in-addr.arpa",
+			output: "192.0.2.128",
+		},
+		"ipv4_upper_case": {
+			input:  "1.2.0.192.IN-ADDR.ARPA.",
+			output: "192.0.2.1",
+		},
+		"ipv4_partial": {
+			input:  "2.0.192.in-addr.arpa.",
+			output: "192.0.2.0/24",
+		},
+		"ipv4_rfc2317": {
+			input:  "64-127.2.0.192.in-addr.arpa.",
+			output: "192.0.2.64/26",
+		},
+		"ipv4_suffix_only": {
+			input:  "in-addr.arpa.",
+			output: "0.0.0.0/0",
+		},
+		"ipv6": {
+			input:  "4.0.0.0.3.0.0.0.2.0.0.0.1.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			output: "2001:db8::1:2:3:4",
+		},
+		"ipv6_upper_case": {
+			input:  "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.B.D.0.1.0.0.2.IP6.ARPA",
+			output: "2001:db8::1",
+		},
+		"ipv6_partial": {
+			input:  "8.b.d.0.1.0.0.2.ip6.arpa.",
+			output: "2001:db8::/32",
+		},
+		"invalid_suffix": {
+			input:       "1.2.0.192.example.com.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_octet": {
+			input:       "256.2.0.192.in-addr.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_empty_label": {
+			input:       "1..0.192.in-addr.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_too_many_labels": {
+			input:       "1.1.2.0.192.in-addr.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_rfc2317": {
+			input:       "1-62.2.0.192.in-addr.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_nibble": {
+			input:       "g.b.d.0.1.0.0.2.ip6.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+		"invalid_nibble_length": {
+			input:       "10.b.d.0.1.0.0.2.ip6.arpa.",
+			expectError: regexp.MustCompile("Invalid PTR name"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ptr_to_address("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ptr_to_address("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ptrZoneModeCovering = "covering"
+	ptrZoneModeRFC2317  = "rfc2317"
+)
+
+var _ function.Function = ptrZoneFunction{}
+
+func newPtrZoneFunction() function.Function {
+	return ptrZoneFunction{}
+}
+
+type ptrZoneFunction struct{}
+
+func (f ptrZoneFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "ptr_zone"
+}
+
+func (f ptrZoneFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate the reverse DNS zone names of a prefix.",
+		Description: "Generate the reverse DNS zone names of a prefix" +
+			" with 'in-addr.arpa.' suffix for IPv4 prefix and 'ip6.arpa.' suffix for IPv6 prefix.\n" +
+			" A prefix not on an octet (IPv4) or nibble (IPv6) boundary returns the zones that cover it (default)" +
+			" or, for an IPv4 prefix longer than /24, the RFC 2317 classless delegation name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:           "mode",
+				Description:    "(Optional) Mode for a prefix not on a boundary: `covering` or `rfc2317`",
+				AllowNullValue: true,
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(
+						ptrZoneModeCovering,
+						ptrZoneModeRFC2317,
+					),
+				},
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f ptrZoneFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputPrefix string
+		inputMode   types.String
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputPrefix, &inputMode))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(inputPrefix, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	mode := ptrZoneModeCovering
+	if !inputMode.IsNull() {
+		mode = inputMode.ValueString()
+	}
+
+	zones := ptrZonesFromPrefix(prefix.Masked(), mode == ptrZoneModeRFC2317)
+	if len(zones) == 0 {
+		// if happen, it's a bug
+		resp.Error = function.NewFuncError("Internal Error," +
+			" this is a bug in the provider, which should be reported in the provider's own issue tracker.")
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, zones))
+}
+
+// ptrZonesFromPrefix returns the reverse DNS zone names of prefix.
+//
+// A prefix not on a boundary of zone labels (octet for IPv4, nibble for IPv6)
+// is covered by the zones of its subnets on the next boundary,
+// except an IPv4 prefix longer than /24 which is covered by the zone of its /24 parent
+// or, with rfc2317, has a RFC 2317 classless delegation name (e.g. 0-63.2.0.192.in-addr.arpa.).
+func ptrZonesFromPrefix(prefix netip.Prefix, rfc2317 bool) []string {
+	if !prefix.IsValid() {
+		return nil
+	}
+
+	labelBits := 4
+	if prefix.Addr().Is4() {
+		labelBits = 8
+	}
+
+	if prefix.Bits()%labelBits == 0 {
+		return []string{ptrZoneName(prefix, labelBits)}
+	}
+
+	if prefix.Addr().Is4() && prefix.Bits() > 24 {
+		parent := netip.PrefixFrom(prefix.Addr(), 24).Masked()
+		if !rfc2317 {
+			return []string{ptrZoneName(parent, labelBits)}
+		}
+
+		first := prefix.Addr().As4()[3]
+		last := prefixLastAddr(prefix).As4()[3]
+
+		return []string{
+			strconv.FormatUint(uint64(first), 10) + "-" + strconv.FormatUint(uint64(last), 10) + "." +
+				ptrZoneName(parent, labelBits),
+		}
+	}
+
+	// at most 128 subnets for IPv4 (split in /24 or larger) and 8 subnets for IPv6
+	bits := (prefix.Bits()/labelBits + 1) * labelBits
+	subnets, ok := prefixSubnets(prefix, bits, 1<<(labelBits-1))
+	if !ok {
+		return nil
+	}
+
+	zones := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		zones[i] = ptrZoneName(subnet, labelBits)
+	}
+
+	return zones
+}
+
+// ptrZoneName returns the reverse DNS zone name of prefix
+// with a prefix length on a boundary of zone labels (labelBits).
+// It's the PTR name of the prefix address without the labels of host bits.
+func ptrZoneName(prefix netip.Prefix, labelBits int) string {
+	name := ptrNameFromIP(prefix.Addr())
+	for range (prefix.Addr().BitLen() - prefix.Bits()) / labelBits {
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return name
+}
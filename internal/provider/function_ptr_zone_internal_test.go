@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPtrZonesFromPrefix(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix  netip.Prefix
+		rfc2317 bool
+		output  []string
+	}
+
+	tests := map[string]testCase{
+		"null": {
+			output: nil,
+		},
+		"ipv4_all": {
+			prefix: netip.MustParsePrefix("0.0.0.0/0"),
+			output: []string{"in-addr.arpa."},
+		},
+		"ipv4_octet": {
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			output: []string{"10.in-addr.arpa."},
+		},
+		"ipv4_split": {
+			prefix: netip.MustParsePrefix("10.2.0.0/15"),
+			output: []string{"2.10.in-addr.arpa.", "3.10.in-addr.arpa."},
+		},
+		"ipv4_covering": {
+			prefix: netip.MustParsePrefix("192.0.2.4/31"),
+			output: []string{"2.0.192.in-addr.arpa."},
+		},
+		"ipv4_rfc2317": {
+			prefix:  netip.MustParsePrefix("192.0.2.4/31"),
+			rfc2317: true,
+			output:  []string{"4-5.2.0.192.in-addr.arpa."},
+		},
+		"ipv4_rfc2317_25": {
+			prefix:  netip.MustParsePrefix("192.0.2.128/25"),
+			rfc2317: true,
+			output:  []string{"128-255.2.0.192.in-addr.arpa."},
+		},
+		"ipv4_rfc2317_split": {
+			prefix:  netip.MustParsePrefix("192.0.2.0/23"),
+			rfc2317: true,
+			output:  []string{"2.0.192.in-addr.arpa.", "3.0.192.in-addr.arpa."},
+		},
+		"ipv6_all": {
+			prefix: netip.MustParsePrefix("::/0"),
+			output: []string{"ip6.arpa."},
+		},
+		"ipv6_nibble": {
+			prefix: netip.MustParsePrefix("2001:db8:a::/48"),
+			output: []string{"a.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		},
+		"ipv6_split": {
+			prefix:  netip.MustParsePrefix("2001:db8::/34"),
+			rfc2317: true,
+			output: []string{
+				"0.8.b.d.0.1.0.0.2.ip6.arpa.",
+				"1.8.b.d.0.1.0.0.2.ip6.arpa.",
+				"2.8.b.d.0.1.0.0.2.ip6.arpa.",
+				"3.8.b.d.0.1.0.0.2.ip6.arpa.",
+			},
+		},
+		"ipv6_host": {
+			prefix: netip.MustParsePrefix("2001:db8::1/128"),
+			output: []string{"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := ptrZonesFromPrefix(test.prefix, test.rfc2317)
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %q, got %q", test.output, result)
+			}
+		})
+	}
+}
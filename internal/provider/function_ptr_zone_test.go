@@ -0,0 +1,159 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionPtrZone(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix      string
+		mode        string
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefix:      "",
+			mode:        `null`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_prefix": {
+			prefix:      "192.0.2.a/24",
+			mode:        `null`,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"invalid_mode": {
+			prefix:      "192.0.2.0/24",
+			mode:        `"classless"`,
+			expectError: regexp.MustCompile("Invalid Parameter Value Match"),
+		},
+		"ipv4_octet": {
+			prefix: "192.0.2.0/24",
+			mode:   `null`,
+			output: []string{
+				"2.0.192.in-addr.arpa.",
+			},
+		},
+		"ipv4_host": {
+			prefix: "192.0.2.1/32",
+			mode:   `"rfc2317"`,
+			output: []string{
+				"1.2.0.192.in-addr.arpa.",
+			},
+		},
+		"ipv4_covering": {
+			prefix: "192.0.2.64/26",
+			mode:   `"covering"`,
+			output: []string{
+				"2.0.192.in-addr.arpa.",
+			},
+		},
+		"ipv4_rfc2317": {
+			prefix: "192.0.2.64/26",
+			mode:   `"rfc2317"`,
+			output: []string{
+				"64-127.2.0.192.in-addr.arpa.",
+			},
+		},
+		"ipv4_rfc2317_29": {
+			prefix: "192.0.2.8/29",
+			mode:   `"rfc2317"`,
+			output: []string{
+				"8-15.2.0.192.in-addr.arpa.",
+			},
+		},
+		"ipv4_split": {
+			prefix: "10.0.0.0/22",
+			mode:   `"rfc2317"`,
+			output: []string{
+				"0.0.10.in-addr.arpa.",
+				"1.0.10.in-addr.arpa.",
+				"2.0.10.in-addr.arpa.",
+				"3.0.10.in-addr.arpa.",
+			},
+		},
+		"ipv6_nibble": {
+			prefix: "2001:db8::/32",
+			mode:   `null`,
+			output: []string{
+				"8.b.d.0.1.0.0.2.ip6.arpa.",
+			},
+		},
+		"ipv6_split": {
+			prefix: "2001:db8::/31",
+			mode:   `null`,
+			output: []string{
+				"8.b.d.0.1.0.0.2.ip6.arpa.",
+				"9.b.d.0.1.0.0.2.ip6.arpa.",
+			},
+		},
+		"ipv6_all": {
+			prefix: "::/0",
+			mode:   `null`,
+			output: []string{
+				"ip6.arpa.",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ptr_zone("` + test.prefix + `", ` + test.mode + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ptr_zone("` + test.prefix + `", ` + test.mode + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = rangesToPrefixesFunction{}
+
+func newRangesToPrefixesFunction() function.Function {
+	return rangesToPrefixesFunction{}
+}
+
+type rangesToPrefixesFunction struct{}
+
+func (f rangesToPrefixesFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "ranges_to_prefixes"
+}
+
+func (f rangesToPrefixesFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a list of IP ranges to a list of CIDR prefixes.",
+		Description: "Convert each IP range of a list (start-end notation)" +
+			" into the minimal list of CIDR prefixes that exactly cover the range" +
+			" and return all prefixes in the order of the list.\n" +
+			" IP addresses and prefixes are also accepted and returned as prefixes.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "List of IP ranges, addresses and prefixes to convert",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f rangesToPrefixesFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	result := make([]string, 0, len(inputs))
+	for _, item := range inputs {
+		prefixes, funcErr := parseRangeOrPrefixInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
+		}
+		for _, prefix := range prefixes {
+			result = append(result, prefix.Masked().String())
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// isRangeInput reports whether input uses the range notation (start-end)
+// instead of an address with or without mask.
+//
+// An address with a zone can contain a hyphen (e.g. fe80::1%eth-0),
+// so input is not a range if the part before the hyphen has a zone.
+func isRangeInput(input string) bool {
+	start, _, found := strings.Cut(input, "-")
+
+	return found && !strings.Contains(start, "%") && !strings.Contains(input, "/")
+}
+
+// parseRangeInput parses a range of IP addresses in start-end notation
+// and returns an argument error with position if the input is invalid.
+//
+// The end address can be shortened to the last part of the address:
+// the last octet in decimal for IPv4 (e.g. 10.0.0.1-20)
+// or the last 16-bit group in hexadecimal for IPv6 (e.g. 2001:db8::1-ff).
+func parseRangeInput(input string, position int64) (netip.Addr, netip.Addr, *function.FuncError) {
+	invalidRange := func(detail string) *function.FuncError {
+		return function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid range"),
+			function.NewFuncError("unable to parse range input "+strconv.Quote(input)+": "+detail),
+		)
+	}
+
+	inputStart, inputEnd, found := strings.Cut(input, "-")
+	if !found {
+		return netip.Addr{}, netip.Addr{}, invalidRange("missing hyphen between start and end addresses")
+	}
+	inputStart = strings.TrimSpace(inputStart)
+	inputEnd = strings.TrimSpace(inputEnd)
+
+	start, err := netip.ParseAddr(inputStart)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, invalidRange("invalid start address: " + err.Error())
+	}
+	if start.Zone() != "" {
+		return netip.Addr{}, netip.Addr{}, invalidRange("start address must not have a zone")
+	}
+
+	// short end address if it has no separator of IPv4 or IPv6 address
+	shortEnd := !strings.ContainsAny(inputEnd, ".:")
+
+	var end netip.Addr
+	switch {
+	case shortEnd && start.Is4():
+		lastOctet, err := strconv.ParseUint(inputEnd, 10, 8)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, invalidRange("invalid end address: " +
+				"short end address must be the last octet in decimal (0-255)")
+		}
+		b := start.As4()
+		b[3] = byte(lastOctet)
+		end = netip.AddrFrom4(b)
+	case shortEnd:
+		lastGroup, err := strconv.ParseUint(inputEnd, 16, 16)
+		if err != nil || len(inputEnd) > 4 {
+			return netip.Addr{}, netip.Addr{}, invalidRange("invalid end address: " +
+				"short end address must be the last 16-bit group in hexadecimal (0-ffff)")
+		}
+		b := start.As16()
+		b[14] = byte(lastGroup >> 8)
+		b[15] = byte(lastGroup)
+		end = netip.AddrFrom16(b)
+	default:
+		end, err = netip.ParseAddr(inputEnd)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, invalidRange("invalid end address: " + err.Error())
+		}
+		if end.Zone() != "" {
+			return netip.Addr{}, netip.Addr{}, invalidRange("end address must not have a zone")
+		}
+	}
+
+	if start.Is4() != end.Is4() {
+		return netip.Addr{}, netip.Addr{}, invalidRange("start and end addresses must be the same IP version")
+	}
+	if end.Less(start) {
+		return netip.Addr{}, netip.Addr{}, invalidRange("start address must be less than or equal to end address")
+	}
+
+	return start, end, nil
+}
+
+// parseRangeOrPrefixInput parses a range of IP addresses in start-end notation
+// or an address with or without mask to the minimal list of prefixes that cover it
+// and returns an argument error with position if the input is invalid.
+func parseRangeOrPrefixInput(input string, position int64) ([]netip.Prefix, *function.FuncError) {
+	if isRangeInput(input) {
+		start, end, funcErr := parseRangeInput(input, position)
+		if funcErr != nil {
+			return nil, funcErr
+		}
+
+		return rangeToPrefixes(start, end), nil
+	}
+
+	prefix, funcErr := parsePrefixOrAddressInput(input, position)
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	return []netip.Prefix{prefix}, nil
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParseRangeInput(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input     string
+		expectErr bool
+		start     netip.Addr
+		end       netip.Addr
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			input: "10.0.0.1-10.0.0.20",
+			start: netip.MustParseAddr("10.0.0.1"),
+			end:   netip.MustParseAddr("10.0.0.20"),
+		},
+		"ipv4_spaces": {
+			input: " 10.0.0.1 - 10.0.1.20 ",
+			start: netip.MustParseAddr("10.0.0.1"),
+			end:   netip.MustParseAddr("10.0.1.20"),
+		},
+		"ipv4_short": {
+			input: "10.0.0.1-20",
+			start: netip.MustParseAddr("10.0.0.1"),
+			end:   netip.MustParseAddr("10.0.0.20"),
+		},
+		"ipv4_single": {
+			input: "10.0.0.1-1",
+			start: netip.MustParseAddr("10.0.0.1"),
+			end:   netip.MustParseAddr("10.0.0.1"),
+		},
+		"ipv6": {
+			input: "2001:db8::1-2001:db8::1:ff",
+			start: netip.MustParseAddr("2001:db8::1"),
+			end:   netip.MustParseAddr("2001:db8::1:ff"),
+		},
+		"ipv6_short": {
+			input: "2001:db8::1-ff",
+			start: netip.MustParseAddr("2001:db8::1"),
+			end:   netip.MustParseAddr("2001:db8::ff"),
+		},
+		"ipv6_short_full_group": {
+			input: "2001:db8::1-FFFF",
+			start: netip.MustParseAddr("2001:db8::1"),
+			end:   netip.MustParseAddr("2001:db8::ffff"),
+		},
+		"invalid_start": {
+			input:     "10.0.0.a-10.0.0.20",
+			expectErr: true,
+		},
+		"invalid_end": {
+			input:     "10.0.0.1-10.0.0.a",
+			expectErr: true,
+		},
+		"invalid_ipv4_short": {
+			input:     "10.0.0.1-256",
+			expectErr: true,
+		},
+		"invalid_ipv4_short_hexadecimal": {
+			input:     "10.0.0.1-ff",
+			expectErr: true,
+		},
+		"invalid_ipv6_short": {
+			input:     "2001:db8::1-10000",
+			expectErr: true,
+		},
+		"invalid_ipv6_short_too_long": {
+			input:     "2001:db8::1-000ff",
+			expectErr: true,
+		},
+		"invalid_empty_end": {
+			input:     "10.0.0.1-",
+			expectErr: true,
+		},
+		"invalid_order": {
+			input:     "10.0.0.20-10.0.0.1",
+			expectErr: true,
+		},
+		"invalid_short_order": {
+			input:     "10.0.0.20-1",
+			expectErr: true,
+		},
+		"invalid_version": {
+			input:     "10.0.0.1-2001:db8::1",
+			expectErr: true,
+		},
+		"invalid_version_mapped": {
+			input:     "::ffff:10.0.0.1-10.0.0.20",
+			expectErr: true,
+		},
+		"invalid_zone": {
+			input:     "fe80::1-fe80::2%eth0",
+			expectErr: true,
+		},
+		"invalid_no_hyphen": {
+			input:     "10.0.0.1",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end, funcErr := parseRangeInput(test.input, 0)
+			if test.expectErr {
+				if funcErr == nil {
+					t.Errorf("expected error, got start %s and end %s", start, end)
+				}
+
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Error())
+			}
+			if start != test.start || end != test.end {
+				t.Errorf("got unexpected result: want %s-%s, got %s-%s", test.start, test.end, start, end)
+			}
+		})
+	}
+}
+
+func TestIsRangeInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		"10.0.0.1-10.0.0.20": true,
+		"10.0.0.1-20":        true,
+		"2001:db8::1-ff":     true,
+		"10.0.0.1":           false,
+		"10.0.0.0/24":        false,
+		"10.0.0.0-1/24":      false,
+		"fe80::1%eth-0":      false,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if result := isRangeInput(input); result != expected {
+				t.Errorf("got unexpected result for %q: want %t, got %t", input, expected, result)
+			}
+		})
+	}
+}
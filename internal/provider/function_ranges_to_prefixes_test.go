@@ -0,0 +1,162 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionRangesToPrefixes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       []string
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"ranges": {
+			input: []string{
+				"10.0.0.5-10.0.0.20",
+				"10.0.0.1-3",
+			},
+			output: []string{
+				"10.0.0.5/32",
+				"10.0.0.6/31",
+				"10.0.0.8/29",
+				"10.0.0.16/30",
+				"10.0.0.20/32",
+				"10.0.0.1/32",
+				"10.0.0.2/31",
+			},
+		},
+		"ipv6": {
+			input: []string{
+				"2001:db8::-2001:db8::ff",
+				"2001:db8::1:0-ffff",
+			},
+			output: []string{
+				"2001:db8::/120",
+				"2001:db8::1:0/112",
+			},
+		},
+		"addresses_and_prefixes": {
+			input: []string{
+				"192.0.2.1/24",
+				"10.0.0.1",
+				"10.0.0.2-3",
+			},
+			output: []string{
+				"192.0.2.0/24",
+				"10.0.0.1/32",
+				"10.0.0.2/31",
+			},
+		},
+		"all": {
+			input: []string{
+				"0.0.0.0-255.255.255.255",
+			},
+			output: []string{
+				"0.0.0.0/0",
+			},
+		},
+		"empty_list": {
+			input:  []string{},
+			output: []string{},
+		},
+		"invalid_start": {
+			input: []string{
+				"10.0.0.a-10.0.0.5",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_short_end": {
+			input: []string{
+				"10.0.0.1-256",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_order": {
+			input: []string{
+				"10.0.0.5-10.0.0.1",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_version": {
+			input: []string{
+				"10.0.0.1-2001:db8::1",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_address": {
+			input: []string{
+				"10.0.0.a",
+			},
+			expectError: regexp.MustCompile("Invalid address"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quotedInput := make([]string, len(test.input))
+			for i, v := range test.input {
+				quotedInput[i] = fmt.Sprintf("%q", v)
+			}
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ranges_to_prefixes([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::ranges_to_prefixes([` + strings.Join(quotedInput, ", ") + `])
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
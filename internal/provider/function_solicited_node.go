@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = solicitedNodeFunction{}
+
+func newSolicitedNodeFunction() function.Function {
+	return solicitedNodeFunction{}
+}
+
+type solicitedNodeFunction struct{}
+
+func (f solicitedNodeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "solicited_node"
+}
+
+func (f solicitedNodeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Generate the solicited-node multicast address of an IPv6 address.",
+		Description: "Generate the solicited-node multicast address (ff02::1:ff00:0/104 prefix" +
+			" with the low-order 24 bits of address) of an IPv6 unicast or anycast address," +
+			" as defined in RFC 4291 section 2.7.1.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "IPv6 address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f solicitedNodeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	address, funcErr := parseAddressInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+	if !address.Is6() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("address must be an IPv6 address"),
+		)
+
+		return
+	}
+	if address.IsMulticast() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, "Invalid address"),
+			function.NewFuncError("address must be a unicast or anycast address, not a multicast address"),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, solicitedNodeAddress(address).String()))
+}
+
+// solicitedNodeAddress returns the solicited-node multicast address of an IPv6 address:
+// the ff02::1:ff00:0/104 prefix with the low-order 24 bits of address.
+func solicitedNodeAddress(address netip.Addr) netip.Addr {
+	b := address.As16()
+
+	return netip.AddrFrom16([16]byte{
+		0xff, 0x02, 11: 0x01, 12: 0xff,
+		13: b[13], 14: b[14], 15: b[15],
+	})
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"net/netip"
 	"slices"
@@ -18,6 +19,10 @@ func newSortFunction() function.Function {
 
 type sortFunction struct{}
 
+// sortRangeBits is the value used in place of a mask length to sort a range (start-end notation)
+// after the address and the CIDR addresses with the same start address.
+const sortRangeBits = 129
+
 func (f sortFunction) Metadata(
 	_ context.Context,
 	_ function.MetadataRequest,
@@ -33,14 +38,15 @@ func (f sortFunction) Definition(
 ) {
 	resp.Definition = function.Definition{
 		Summary: "Sort IP addresses with or without mask in numerical order.",
-		Description: "Sort a list of IP addresses with or without mask in numerical order. " +
+		Description: "Sort a list of IP addresses with or without mask and IP ranges in numerical order. " +
 			"When two entries share the same address, the address without mask comes first, " +
-			"then CIDR addresses are sorted by mask length (shortest first).",
+			"then CIDR addresses are sorted by mask length (shortest first), " +
+			"then ranges (start-end notation) are sorted by end address.",
 		Parameters: []function.Parameter{
 			function.ListParameter{
 				ElementType: types.StringType,
 				Name:        "inputs",
-				Description: "List of IP addresses to sort",
+				Description: "List of IP addresses and ranges to sort",
 			},
 		},
 		Return: function.ListReturn{
@@ -64,11 +70,21 @@ func (f sortFunction) Run(
 		raw  string
 		addr netip.Addr
 		bits int
+		end  netip.Addr
 	}
 
 	entries := make([]entry, 0, len(inputs))
 	for _, item := range inputs {
-		if strings.Contains(item, "/") {
+		switch {
+		case isRangeInput(item):
+			start, end, funcErr := parseRangeInput(item, 0)
+			if funcErr != nil {
+				resp.Error = funcErr
+
+				return
+			}
+			entries = append(entries, entry{raw: item, addr: start, bits: sortRangeBits, end: end})
+		case strings.Contains(item, "/"):
 			prefix, err := netip.ParsePrefix(item)
 			if err != nil {
 				resp.Error = function.ConcatFuncErrors(
@@ -79,7 +95,7 @@ func (f sortFunction) Run(
 				return
 			}
 			entries = append(entries, entry{raw: item, addr: prefix.Addr(), bits: prefix.Bits()})
-		} else {
+		default:
 			addr, err := netip.ParseAddr(item)
 			if err != nil {
 				resp.Error = function.ConcatFuncErrors(
@@ -94,11 +110,15 @@ func (f sortFunction) Run(
 	}
 
 	slices.SortStableFunc(entries, func(a, b entry) int {
-		if cmp := a.addr.Compare(b.addr); cmp != 0 {
-			return cmp
+		if c := a.addr.Compare(b.addr); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.bits, b.bits); c != 0 {
+			return c
 		}
 
-		return a.bits - b.bits
+		// ranges with the same start address are sorted by end address
+		return a.end.Compare(b.end)
 	})
 
 	result := make([]string, len(entries))
@@ -162,6 +162,31 @@ func TestAccFunctionSort(t *testing.T) {
 				"::10",
 			},
 		},
+		"ranges": {
+			input: []string{
+				"10.0.0.0-10.0.0.20",
+				"2001:db8::1-ff",
+				"10.0.0.0/24",
+				"10.0.0.0-5",
+				"10.0.0.0",
+				"9.0.0.0-9.255.255.255",
+			},
+			output: []string{
+				"9.0.0.0-9.255.255.255",
+				"10.0.0.0",
+				"10.0.0.0/24",
+				"10.0.0.0-5",
+				"10.0.0.0-10.0.0.20",
+				"2001:db8::1-ff",
+			},
+		},
+		"invalid_range": {
+			input: []string{
+				"10.0.0.1",
+				"10.0.0.5-10.0.0.1",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = subnetFunction{}
+
+func newSubnetFunction() function.Function {
+	return subnetFunction{}
+}
+
+type subnetFunction struct{}
+
+func (f subnetFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "subnet"
+}
+
+func (f subnetFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate a subnet of a prefix with a specific prefix length and network number.",
+		Description: "Calculate a subnet of a prefix with a specific prefix length and network number.\n" +
+			" Network number is a string to allow numbers above 64 bits" +
+			" and can be negative to count from the end of the prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.Int32Parameter{
+				Name:        "new_bits",
+				Description: "Prefix length of subnet",
+				Validators: []function.Int32ParameterValidator{
+					int32validator.Between(0, 128),
+				},
+			},
+			function.StringParameter{
+				Name:        "netnum",
+				Description: "Network number of subnet in decimal or hexadecimal (0x prefix) format",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f subnetFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputPrefix, inputNetnum string
+		inputNewBits             int32
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputPrefix, &inputNewBits, &inputNetnum))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(inputPrefix, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	if int(inputNewBits) < prefix.Bits() || int(inputNewBits) > prefix.Addr().BitLen() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Invalid new_bits"),
+			function.NewFuncError(fmt.Sprintf("must be between %d and %d for prefix %s",
+				prefix.Bits(), prefix.Addr().BitLen(), prefix.Masked().String())),
+		)
+
+		return
+	}
+
+	netnum, ok := parseBigInt(inputNetnum)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, "Invalid netnum"),
+			function.NewFuncError("unable to parse netnum input: "+
+				"must be an integer in decimal or hexadecimal (0x prefix) format"),
+		)
+
+		return
+	}
+
+	output, ok := prefixSubnet(prefix, int(inputNewBits), netnum)
+	if !ok {
+		count := new(big.Int).Lsh(big.NewInt(1), uint(inputNewBits)-uint(prefix.Bits()))
+		maxNetnum := new(big.Int).Sub(count, big.NewInt(1))
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, "Invalid netnum"),
+			function.NewFuncError(fmt.Sprintf("must be between -%s and %s for /%d subnets of %s",
+				count.String(), maxNetnum.String(), inputNewBits, prefix.Masked().String())),
+		)
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output.String()))
+}
+
+// prefixSubnet returns the child prefix of prefix with a prefix length of bits
+// and the network number netnum (a negative netnum counts from the end of prefix).
+// It returns false if bits or netnum are invalid for prefix.
+func prefixSubnet(prefix netip.Prefix, bits int, netnum *big.Int) (netip.Prefix, bool) {
+	if !prefix.IsValid() || bits < prefix.Bits() || bits > prefix.Addr().BitLen() {
+		return netip.Prefix{}, false
+	}
+
+	// number of child prefixes is 2^(bits - prefix bits)
+	count := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix.Bits()))
+
+	index := new(big.Int).Set(netnum)
+	if index.Sign() < 0 {
+		index.Add(index, count)
+	}
+	if index.Sign() < 0 || index.Cmp(count) >= 0 {
+		return netip.Prefix{}, false
+	}
+
+	// address of subnet is address of prefix + index * 2^(address bits - bits)
+	value := index.Lsh(index, uint(prefix.Addr().BitLen()-bits))
+	value.Add(value, addrToBigInt(prefix.Masked().Addr()))
+
+	addr, ok := addrFromBigInt(value, prefix.Addr().Is4())
+	if !ok {
+		return netip.Prefix{}, false
+	}
+
+	return netip.PrefixFrom(addr, bits), true
+}
@@ -0,0 +1,184 @@
+package provider_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionSubnet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix      string
+		newBits     int
+		netnum      string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty_prefix": {
+			prefix:      "",
+			newBits:     24,
+			netnum:      "0",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"empty_netnum": {
+			prefix:      "10.0.0.0/8",
+			newBits:     24,
+			netnum:      "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_prefix": {
+			prefix:      "10.0.0.a/8",
+			newBits:     24,
+			netnum:      "0",
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"ipv4_first": {
+			prefix:  "10.0.0.0/8",
+			newBits: 16,
+			netnum:  "0",
+			output:  "10.0.0.0/16",
+		},
+		"ipv4": {
+			prefix:  "10.0.0.0/8",
+			newBits: 16,
+			netnum:  "2",
+			output:  "10.2.0.0/16",
+		},
+		"ipv4_hex": {
+			prefix:  "10/8",
+			newBits: 16,
+			netnum:  "0xff",
+			output:  "10.255.0.0/16",
+		},
+		"ipv4_negative": {
+			prefix:  "10.0.0.0/8",
+			newBits: 16,
+			netnum:  "-1",
+			output:  "10.255.0.0/16",
+		},
+		"ipv4_negative_first": {
+			prefix:  "10.0.0.0/8",
+			newBits: 16,
+			netnum:  "-256",
+			output:  "10.0.0.0/16",
+		},
+		"ipv4_out_of_range": {
+			prefix:      "10.0.0.0/8",
+			newBits:     16,
+			netnum:      "256",
+			expectError: regexp.MustCompile("Invalid netnum"),
+		},
+		"ipv4_negative_out_of_range": {
+			prefix:      "10.0.0.0/8",
+			newBits:     16,
+			netnum:      "-257",
+			expectError: regexp.MustCompile("Invalid netnum"),
+		},
+		"ipv4_invalid_netnum": {
+			prefix:      "10.0.0.0/8",
+			newBits:     16,
+			netnum:      "1a",
+			expectError: regexp.MustCompile("Invalid netnum"),
+		},
+		"ipv4_invalid_new_bits": {
+			prefix:      "10.0.0.0/8",
+			newBits:     7,
+			netnum:      "0",
+			expectError: regexp.MustCompile("Invalid new_bits"),
+		},
+		"ipv6": {
+			prefix:  "2001:db8::/32",
+			newBits: 48,
+			netnum:  "10",
+			output:  "2001:db8:a::/48",
+		},
+		"ipv6_large": {
+			prefix:  "2001:db8::/64",
+			newBits: 104,
+			netnum:  "1099511627775",
+			output:  "2001:db8::ffff:ffff:ff00:0/104",
+		},
+		"ipv6_large_hex": {
+			prefix:  "2001:db8::/64",
+			newBits: 104,
+			netnum:  "0xFFFFFFFFFF",
+			output:  "2001:db8::ffff:ffff:ff00:0/104",
+		},
+		"ipv6_above_int64": {
+			prefix:  "::/0",
+			newBits: 128,
+			netnum:  "42540766411282592856903984951653826561",
+			output:  "2001:db8::1/128",
+		},
+		"ipv6_large_out_of_range": {
+			prefix:      "2001:db8::/64",
+			newBits:     104,
+			netnum:      "1099511627776",
+			expectError: regexp.MustCompile("Invalid netnum"),
+		},
+		"ipv6_negative": {
+			prefix:  "2001:db8::/64",
+			newBits: 104,
+			netnum:  "-1",
+			output:  "2001:db8::ffff:ffff:ff00:0/104",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::subnet("` + test.prefix + `", ` +
+								strconv.Itoa(test.newBits) + `, "` + test.netnum + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::subnet("` + test.prefix + `", ` +
+								strconv.Itoa(test.newBits) + `, "` + test.netnum + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = subnetsFunction{}
+
+func newSubnetsFunction() function.Function {
+	return subnetsFunction{}
+}
+
+type subnetsFunction struct{}
+
+func (f subnetsFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "subnets"
+}
+
+func (f subnetsFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Enumerate the subnets of a prefix with a specific prefix length.",
+		Description: "Enumerate, in numerical order, all the child prefixes of a prefix" +
+			" with a specific prefix length.\n" +
+			" Generation is limited by a maximum number of subnets.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.Int32Parameter{
+				Name:        "new_bits",
+				Description: "Prefix length of subnets",
+				Validators: []function.Int32ParameterValidator{
+					int32validator.Between(0, 128),
+				},
+			},
+			function.Int32Parameter{
+				Name:        "limit",
+				Description: "Maximum number of subnets",
+				Validators: []function.Int32ParameterValidator{
+					int32validator.AtLeast(1),
+				},
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f subnetsFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		inputPrefix              string
+		inputNewBits, inputLimit int32
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputPrefix, &inputNewBits, &inputLimit))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCIDRInput(inputPrefix, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	if int(inputNewBits) < prefix.Bits() || int(inputNewBits) > prefix.Addr().BitLen() {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, "Invalid new_bits"),
+			function.NewFuncError(fmt.Sprintf("must be between %d and %d for prefix %s",
+				prefix.Bits(), prefix.Addr().BitLen(), prefix.Masked().String())),
+		)
+
+		return
+	}
+
+	subnets, ok := prefixSubnets(prefix, int(inputNewBits), int64(inputLimit))
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, "Too many subnets"),
+			function.NewFuncError(fmt.Sprintf("splitting %s into /%d subnets generates more than %d subnets",
+				prefix.Masked().String(), inputNewBits, inputLimit)),
+		)
+
+		return
+	}
+
+	result := make([]string, len(subnets))
+	for i, p := range subnets {
+		result[i] = p.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// prefixSubnets returns, in numerical order, the child prefixes of prefix
+// with a prefix length of bits.
+// It returns false if bits is invalid for prefix
+// or if the number of child prefixes exceeds limit.
+func prefixSubnets(prefix netip.Prefix, bits int, limit int64) ([]netip.Prefix, bool) {
+	if !prefix.IsValid() || bits < prefix.Bits() || bits > prefix.Addr().BitLen() {
+		return nil, false
+	}
+
+	// number of child prefixes is 2^(bits - prefix bits)
+	diffBits := bits - prefix.Bits()
+	if diffBits >= 63 || int64(1)<<diffBits > limit {
+		return nil, false
+	}
+
+	count := int64(1) << diffBits
+	subnets := make([]netip.Prefix, 0, count)
+	current := prefix.Masked().Addr()
+	for range count {
+		subnet := netip.PrefixFrom(current, bits)
+		subnets = append(subnets, subnet)
+
+		current = prefixLastAddr(subnet).Next()
+	}
+
+	return subnets, true
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestPrefixSubnets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix   netip.Prefix
+		bits     int
+		limit    int64
+		expectOk bool
+		output   []netip.Prefix
+	}
+
+	tests := map[string]testCase{
+		"ipv4": {
+			prefix:   netip.MustParsePrefix("192.0.2.0/24"),
+			bits:     25,
+			limit:    2,
+			expectOk: true,
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/25"),
+				netip.MustParsePrefix("192.0.2.128/25"),
+			},
+		},
+		"ipv4_not_masked": {
+			prefix:   netip.MustParsePrefix("192.0.2.1/24"),
+			bits:     25,
+			limit:    2,
+			expectOk: true,
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/25"),
+				netip.MustParsePrefix("192.0.2.128/25"),
+			},
+		},
+		"ipv4_same_bits": {
+			prefix:   netip.MustParsePrefix("192.0.2.0/24"),
+			bits:     24,
+			limit:    1,
+			expectOk: true,
+			output: []netip.Prefix{
+				netip.MustParsePrefix("192.0.2.0/24"),
+			},
+		},
+		"ipv4_bits_too_small": {
+			prefix:   netip.MustParsePrefix("192.0.2.0/24"),
+			bits:     23,
+			limit:    1,
+			expectOk: false,
+		},
+		"ipv4_bits_too_big": {
+			prefix:   netip.MustParsePrefix("192.0.2.0/24"),
+			bits:     33,
+			limit:    1,
+			expectOk: false,
+		},
+		"ipv4_over_limit": {
+			prefix:   netip.MustParsePrefix("192.0.2.0/24"),
+			bits:     26,
+			limit:    3,
+			expectOk: false,
+		},
+		"ipv4_last": {
+			prefix:   netip.MustParsePrefix("255.255.255.254/31"),
+			bits:     32,
+			limit:    2,
+			expectOk: true,
+			output: []netip.Prefix{
+				netip.MustParsePrefix("255.255.255.254/32"),
+				netip.MustParsePrefix("255.255.255.255/32"),
+			},
+		},
+		"ipv6": {
+			prefix:   netip.MustParsePrefix("2001:db8::/63"),
+			bits:     64,
+			limit:    2,
+			expectOk: true,
+			output: []netip.Prefix{
+				netip.MustParsePrefix("2001:db8::/64"),
+				netip.MustParsePrefix("2001:db8:0:1::/64"),
+			},
+		},
+		"ipv6_huge": {
+			prefix:   netip.MustParsePrefix("::/0"),
+			bits:     128,
+			limit:    1 << 62,
+			expectOk: false,
+		},
+		"invalid": {
+			prefix:   netip.Prefix{},
+			bits:     0,
+			limit:    1,
+			expectOk: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := prefixSubnets(test.prefix, test.bits, test.limit)
+			if ok != test.expectOk {
+				t.Errorf("got unexpected ok: want %t, got %t", test.expectOk, ok)
+			}
+			if !slices.Equal(result, test.output) {
+				t.Errorf("got unexpected result: want %v, got %v", test.output, result)
+			}
+		})
+	}
+}
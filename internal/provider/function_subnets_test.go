@@ -0,0 +1,197 @@
+package provider_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionSubnets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		prefix      string
+		newBits     int
+		limit       int
+		expectError *regexp.Regexp
+		output      []string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			prefix:      "",
+			newBits:     24,
+			limit:       10,
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"invalid_prefix": {
+			prefix:      "192.0.2.a/24",
+			newBits:     26,
+			limit:       10,
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"ipv4": {
+			prefix:  "192.0.2.0/24",
+			newBits: 26,
+			limit:   4,
+			output: []string{
+				"192.0.2.0/26",
+				"192.0.2.64/26",
+				"192.0.2.128/26",
+				"192.0.2.192/26",
+			},
+		},
+		"ipv4_not_canonical": {
+			prefix:  "192.0.2.130/25",
+			newBits: 27,
+			limit:   4,
+			output: []string{
+				"192.0.2.128/27",
+				"192.0.2.160/27",
+				"192.0.2.192/27",
+				"192.0.2.224/27",
+			},
+		},
+		"ipv4_lenient": {
+			prefix:  "10.1/255.255.0.0",
+			newBits: 18,
+			limit:   4,
+			output: []string{
+				"10.1.0.0/18",
+				"10.1.64.0/18",
+				"10.1.128.0/18",
+				"10.1.192.0/18",
+			},
+		},
+		"ipv4_same_bits": {
+			prefix:  "192.0.2.0/24",
+			newBits: 24,
+			limit:   1,
+			output: []string{
+				"192.0.2.0/24",
+			},
+		},
+		"ipv4_end_of_space": {
+			prefix:  "255.255.255.252/30",
+			newBits: 32,
+			limit:   4,
+			output: []string{
+				"255.255.255.252/32",
+				"255.255.255.253/32",
+				"255.255.255.254/32",
+				"255.255.255.255/32",
+			},
+		},
+		"ipv4_new_bits_too_small": {
+			prefix:      "192.0.2.0/24",
+			newBits:     23,
+			limit:       10,
+			expectError: regexp.MustCompile("Invalid new_bits"),
+		},
+		"ipv4_new_bits_too_big": {
+			prefix:      "192.0.2.0/24",
+			newBits:     33,
+			limit:       10,
+			expectError: regexp.MustCompile("Invalid new_bits"),
+		},
+		"ipv4_limit_exceeded": {
+			prefix:      "192.0.2.0/24",
+			newBits:     26,
+			limit:       3,
+			expectError: regexp.MustCompile("Too many subnets"),
+		},
+		"ipv4_invalid_limit": {
+			prefix:      "192.0.2.0/24",
+			newBits:     26,
+			limit:       0,
+			expectError: regexp.MustCompile("Invalid Parameter Value"),
+		},
+		"ipv6": {
+			prefix:  "2001:db8::/62",
+			newBits: 64,
+			limit:   10,
+			output: []string{
+				"2001:db8::/64",
+				"2001:db8:0:1::/64",
+				"2001:db8:0:2::/64",
+				"2001:db8:0:3::/64",
+			},
+		},
+		"ipv6_zero": {
+			prefix:  "::/0",
+			newBits: 2,
+			limit:   4,
+			output: []string{
+				"::/2",
+				"4000::/2",
+				"8000::/2",
+				"c000::/2",
+			},
+		},
+		"ipv6_limit_exceeded": {
+			prefix:      "2001:db8::/32",
+			newBits:     64,
+			limit:       65536,
+			expectError: regexp.MustCompile("Too many subnets"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::subnets("` + test.prefix + `", ` +
+								strconv.Itoa(test.newBits) + `, ` + strconv.Itoa(test.limit) + `)
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				expectedValues := make([]knownvalue.Check, len(test.output))
+				for i, v := range test.output {
+					expectedValues[i] = knownvalue.StringExact(v)
+				}
+
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::subnets("` + test.prefix + `", ` +
+								strconv.Itoa(test.newBits) + `, ` + strconv.Itoa(test.limit) + `)
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.ListExact(expectedValues),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
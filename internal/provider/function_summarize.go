@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"net/netip"
 	"slices"
@@ -33,13 +34,13 @@ func (f summarizeFunction) Definition(
 ) {
 	resp.Definition = function.Definition{
 		Summary: "Summarize IP prefixes.",
-		Description: "Summarize a set of IP addresses and prefixes into " +
+		Description: "Summarize a set of IP addresses, prefixes and ranges into " +
 			"the smallest possible list of prefixes that cover the same addresses.",
 		Parameters: []function.Parameter{
 			function.SetParameter{
 				ElementType: types.StringType,
 				Name:        "inputs",
-				Description: "Set of IP addresses and prefixes to summarize",
+				Description: "Set of IP addresses, prefixes and ranges to summarize",
 			},
 		},
 		Return: function.ListReturn{
@@ -62,35 +63,13 @@ func (f summarizeFunction) Run(
 	// Convert all inputs to prefixes
 	prefixes := make([]netip.Prefix, 0, len(inputs))
 	for _, item := range inputs {
-		switch strings.Contains(item, "/") {
-		case true:
-			prefix, err := netip.ParsePrefix(item)
-			if err != nil {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(1, "Invalid CIDR address"),
-					function.NewFuncError("unable to parse address input: "+err.Error()),
-				)
-
-				return
-			}
-			prefixes = append(prefixes, prefix)
-		case false:
-			address, err := netip.ParseAddr(item)
-			if err != nil {
-				resp.Error = function.ConcatFuncErrors(
-					function.NewArgumentFuncError(1, "Invalid address"),
-					function.NewFuncError("unable to parse address input: "+err.Error()),
-				)
-
-				return
-			}
-			switch {
-			case address.Is4():
-				prefixes = append(prefixes, netip.PrefixFrom(address, 32))
-			case address.Is6():
-				prefixes = append(prefixes, netip.PrefixFrom(address, 128))
-			}
+		itemPrefixes, funcErr := parseRangeOrPrefixInput(item, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+
+			return
 		}
+		prefixes = append(prefixes, itemPrefixes...)
 	}
 
 	// Summarize the prefixes
@@ -105,6 +84,34 @@ func (f summarizeFunction) Run(
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
 }
 
+// parsePrefixOrAddressInput parses an address with or without mask to a prefix
+// (address without mask is converted to a /32 or /128 prefix)
+// and returns an argument error with position if the input is invalid.
+func parsePrefixOrAddressInput(input string, position int64) (netip.Prefix, *function.FuncError) {
+	switch strings.Contains(input, "/") {
+	case true:
+		prefix, err := netip.ParsePrefix(input)
+		if err != nil {
+			return netip.Prefix{}, function.ConcatFuncErrors(
+				function.NewArgumentFuncError(position, "Invalid CIDR address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+		}
+
+		return prefix, nil
+	default:
+		address, err := netip.ParseAddr(input)
+		if err != nil {
+			return netip.Prefix{}, function.ConcatFuncErrors(
+				function.NewArgumentFuncError(position, "Invalid address"),
+				function.NewFuncError("unable to parse address input: "+err.Error()),
+			)
+		}
+
+		return netip.PrefixFrom(address, address.BitLen()), nil
+	}
+}
+
 // prefixesSummarize takes a slice of IP prefixes and returns the minimal list
 // that covers the same IP space by merging adjacent and overlapping prefixes.
 func prefixesSummarize(prefixes []netip.Prefix) []netip.Prefix {
@@ -118,8 +125,13 @@ func prefixesSummarize(prefixes []netip.Prefix) []netip.Prefix {
 	}
 
 	// sort prefixes for easier comparison
+	// (by address then by prefix length, shortest first, so a prefix is before the prefixes it contains)
 	slices.SortFunc(prefixes, func(a, b netip.Prefix) int {
-		return a.Addr().Compare(b.Addr())
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Bits(), b.Bits())
 	})
 
 	for {
@@ -424,6 +424,15 @@ func TestPrefixesSummarize(t *testing.T) {
 				netip.MustParsePrefix("192.0.0.0/16"),
 			},
 		},
+		"same_address_larger_after": {
+			input: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/24"),
+				netip.MustParsePrefix("10.0.0.0/23"),
+			},
+			output: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/23"),
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -287,6 +287,32 @@ func TestAccFunctionSummarize(t *testing.T) {
 				"192.168.0.0/23",
 			},
 		},
+		"ranges": {
+			input: []string{
+				"10.0.0.1-10.0.0.20",
+				"10.0.0.0",
+				"10.0.0.21-255",
+				"2001:db8::-2001:db8::1:0",
+			},
+			output: []string{
+				"10.0.0.0/24",
+				"2001:db8::/112",
+				"2001:db8::1:0/128",
+			},
+		},
+		"invalid_range": {
+			input: []string{
+				"10.0.0.0/24",
+				"10.0.0.1-10.0.0.x",
+			},
+			expectError: regexp.MustCompile("Invalid range"),
+		},
+		"invalid_argument_position": {
+			input: []string{
+				"10.0.0.x",
+			},
+			expectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"inputs"\s+parameter`),
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = supernetFunction{}
+
+func newSupernetFunction() function.Function {
+	return supernetFunction{}
+}
+
+type supernetFunction struct{}
+
+func (f supernetFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "supernet"
+}
+
+func (f supernetFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:     "Calculate the smallest prefix that covers a list of IP addresses and prefixes.",
+		Description: "Return the shortest-length single prefix that contains all IP addresses and prefixes of a list.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "inputs",
+				Description: "List of IP addresses and prefixes of the same IP version",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f supernetFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	supernet, funcErr := parseSupernetInputs(inputs, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, supernet.String()))
+}
+
+// parseSupernetInputs parses a list of IP addresses and prefixes
+// and returns the shortest prefix that contains all of them
+// or an argument error with position if the list is empty, has an invalid entry
+// or mixes IPv4 and IPv6.
+func parseSupernetInputs(inputs []string, position int64) (netip.Prefix, *function.FuncError) {
+	if len(inputs) == 0 {
+		return netip.Prefix{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid inputs"),
+			function.NewFuncError("must contain at least one IP address or prefix"),
+		)
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(inputs))
+	for _, item := range inputs {
+		prefix, funcErr := parsePrefixOrAddressInput(item, position)
+		if funcErr != nil {
+			return netip.Prefix{}, funcErr
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	supernet, ok := prefixesSupernet(prefixes)
+	if !ok {
+		return netip.Prefix{}, function.ConcatFuncErrors(
+			function.NewArgumentFuncError(position, "Invalid inputs"),
+			function.NewFuncError("IP addresses and prefixes must be the same IP version"),
+		)
+	}
+
+	return supernet, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = wildcardFunction{}
+
+func newWildcardFunction() function.Function {
+	return wildcardFunction{}
+}
+
+type wildcardFunction struct{}
+
+func (f wildcardFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "wildcard"
+}
+
+func (f wildcardFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Return the wildcard mask of a prefix length or a CIDR address.",
+		Description: "Return the wildcard mask (inverted netmask) in address format of a prefix length" +
+			" (considered as IPv4) or of a CIDR address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "Prefix length or CIDR address to parse",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f wildcardFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	maskBits, is4, funcErr := parseMaskBitsInput(input, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	wildcard := ipAddrInvert(maskBitsToIPAddr(maskBits, is4))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ipAddrMaskString(wildcard)))
+}
@@ -0,0 +1,131 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionWildcard(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		expectError *regexp.Regexp
+		output      string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			input:       "",
+			expectError: regexp.MustCompile("Invalid Parameter Value Length"),
+		},
+		"bits": {
+			input:  "24",
+			output: "0.0.0.255",
+		},
+		"bits_slash": {
+			input:  "/20",
+			output: "0.0.15.255",
+		},
+		"bits_0": {
+			input:  "0",
+			output: "255.255.255.255",
+		},
+		"bits_32": {
+			input:  "32",
+			output: "0.0.0.0",
+		},
+		"ipv4": {
+			input:  "192.0.2.1/26",
+			output: "0.0.0.63",
+		},
+		"ipv4_netmask": {
+			input:  "10.1/255.255.0.0",
+			output: "0.0.255.255",
+		},
+		"ipv6": {
+			input:  "2001:db8::/64",
+			output: "::ffff:ffff:ffff:ffff",
+		},
+		"ipv6_80": {
+			input:  "2001:db8::/80",
+			output: "::ffff:ffff:ffff",
+		},
+		"invalid": {
+			input:       "192.0.2.a/24",
+			expectError: regexp.MustCompile("Invalid CIDR address"),
+		},
+		"non_contiguous_mask": {
+			input:       "10.0.0.0/255.0.255.0",
+			expectError: regexp.MustCompile(`not\s+a\s+contiguous\s+netmask\s+or\s+wildcard\s+mask`),
+		},
+		"wildcard_mask": {
+			input:       "10.0.0.0/0.0.0.255",
+			expectError: regexp.MustCompile(`is\s+a\s+wildcard\s+mask\s+instead\s+of\s+a\s+netmask`),
+		},
+		"bits_too_big": {
+			input:       "33",
+			expectError: regexp.MustCompile("Invalid prefix length"),
+		},
+		"bits_ipv6": {
+			input:       "64",
+			expectError: regexp.MustCompile("Invalid prefix length"),
+		},
+		"ipv6_bits": {
+			input:  "::/64",
+			output: "::ffff:ffff:ffff:ffff",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.expectError != nil {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::wildcard("` + test.input + `")
+							}
+							`,
+							ExpectError: test.expectError,
+						},
+					},
+				})
+			} else {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_8_0),
+					},
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: `
+							output "test" {
+								value = provider::ipnetwork::wildcard("` + test.input + `")
+							}
+							`,
+							ConfigStateChecks: []statecheck.StateCheck{
+								statecheck.ExpectKnownOutputValue(
+									"test",
+									knownvalue.StringExact(test.output),
+								),
+							},
+						},
+					},
+				})
+			}
+		})
+	}
+}
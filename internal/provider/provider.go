@@ -48,29 +48,64 @@ func (p *ipnetworkProvider) DataSources(_ context.Context) []func() datasource.D
 func (p *ipnetworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		newAddressFunction,
+		newAddressAddFunction,
+		newAddressCountFunction,
+		newAddressDecodeFunction,
+		newAddressDistanceFunction,
+		newAddressEncodeFunction,
 		newAddressPortFunction,
+		newAllocateFunction,
 		newBitsFunction,
 		newCidrFunction,
+		newCidrFormatFunction,
+		newCommonPrefixLengthFunction,
 		newContainFunction,
+		newEmbeddedIPv4Function,
 		newEqualAddressFunction,
 		newEqualPrefixFunction,
+		newExcludeFunction,
 		newExpand6Function,
+		newExtractMACFunction,
+		newFormat6Function,
+		newFreePrefixesFunction,
 		newGenerate6EUI64Function,
+		newGenerate6LinkLocalFunction,
+		newGenerate6LinkLocalOpaqueFunction,
 		newGenerate6OpaqueFunction,
+		newGenerate6OpaqueDetailFunction,
+		newHostFunction,
+		newIntersectFunction,
 		newIs4Function,
 		newIs6Function,
+		newIsEUI64Function,
 		newIsPrivateFunction,
 		newIsPrivateRFC1918Function,
 		newIsPrivateRFC4193Function,
 		newIsPrivateRFC6598Function,
 		newIsPublicFunction,
+		newMaskBitsFunction,
+		newMulticastMACFunction,
+		newNetmaskFunction,
+		newNextFreePrefixFunction,
+		newOverlapsFunction,
 		newPrefixFunction,
+		newPrefixInfoFunction,
+		newPrefixToRangeFunction,
+		newPrefixesToRangesFunction,
 		newPtrFunction,
+		newPtrToAddressFunction,
+		newPtrZoneFunction,
 		newRangeToPrefixesFunction,
+		newRangesToPrefixesFunction,
+		newSolicitedNodeFunction,
 		newSortFunction,
+		newSubnetFunction,
+		newSubnetsFunction,
 		newSummarizeFunction,
+		newSupernetFunction,
 		newTranslate4to6Function,
 		newTranslate6to4Function,
+		newWildcardFunction,
 	}
 }
 